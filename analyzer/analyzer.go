@@ -0,0 +1,204 @@
+package analyzer
+
+import (
+	"encoding/json"
+	"fmt"
+	"gosen/langDetect"
+	"gosen/tokenizer"
+)
+
+// Token of an analyzed text. Position is the position of the word the token is produced from, Start and End are the
+// byte offsets [Start, End) of that word in the text. Expansions (e.g. ngrams, sub-words) accompany the words at their
+// positions, they are searched loosely and are never part of phrases. Ngram marks the expansions which are ngrams of
+// their words
+type Token struct {
+	Text      string
+	Position  uint
+	Start     int
+	End       int
+	Expansion bool
+	Ngram     bool
+}
+
+// Filter transforms the stream of tokens produced by the tokenizer, dropping, rewriting or adding tokens
+type Filter interface {
+	Apply(tokens []Token) []Token
+}
+
+// Analyzer turns a text into tokens, by splitting it into words using a tokenizer and passing them through filters
+type Analyzer struct {
+	Config       Config
+	newTokenizer func(text string) tokenizer.Tokenizer
+	// Tokenizer of the source code, see Config.SourceTokenizer
+	newSourceTokenizer func(text string) tokenizer.Tokenizer
+	filters            []Filter
+	// Filters of each of the detected languages, only set when detecting the languages
+	filtersByLanguage map[string][]Filter
+}
+
+func mkFilters(config Config) ([]Filter, error) {
+	var ret []Filter
+	for _, filterConfig := range config.Filters {
+		filter, err := mkFilter(filterConfig)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, filter)
+	}
+	return ret, nil
+}
+
+// Constructs the analyzer described by the configuration
+func New(config Config) (*Analyzer, error) {
+	newTokenizer, err := mkTokenizer(config.Tokenizer)
+	if err != nil {
+		return nil, fmt.Errorf("analyzer.New: invalid analyzer `%s`: %w", config.Name, err)
+	}
+	filters, err := mkFilters(config)
+	if err != nil {
+		return nil, fmt.Errorf("analyzer.New: invalid analyzer `%s`: %w", config.Name, err)
+	}
+	ret := &Analyzer{Config: config, newTokenizer: newTokenizer, newSourceTokenizer: newTokenizer, filters: filters}
+	if config.SourceTokenizer != "" {
+		if ret.newSourceTokenizer, err = mkTokenizer(config.SourceTokenizer); err != nil {
+			return nil, fmt.Errorf("analyzer.New: invalid analyzer `%s`: %w", config.Name, err)
+		}
+	}
+	if config.DetectLanguage {
+		ret.filtersByLanguage = map[string][]Filter{}
+		for _, language := range langDetect.Languages() {
+			languageConfig, err := config.WithLanguage(language)
+			if err != nil {
+				return nil, fmt.Errorf("analyzer.New: invalid analyzer `%s`: %w", config.Name, err)
+			}
+			if ret.filtersByLanguage[language], err = mkFilters(languageConfig); err != nil {
+				return nil, fmt.Errorf("analyzer.New: invalid analyzer `%s`: %w", config.Name, err)
+			}
+		}
+		if ret.filtersByLanguage[langDetect.Unknown], err = mkFilters(config.withoutLanguage()); err != nil {
+			return nil, fmt.Errorf("analyzer.New: invalid analyzer `%s`: %w", config.Name, err)
+		}
+	}
+	return ret, nil
+}
+
+// Constructs the analyzer from its configuration serialized as JSON, see ToJSON
+func FromJSON(configJSON string) (*Analyzer, error) {
+	var config Config
+	if err := json.Unmarshal([]byte(configJSON), &config); err != nil {
+		return nil, fmt.Errorf("analyzer.FromJSON: cannot convert from JSON: %w", err)
+	}
+	return New(config)
+}
+
+// Serializes the configuration of the analyzer as JSON
+func (analyzer *Analyzer) ToJSON() (string, error) {
+	bytes, err := json.Marshal(analyzer.Config)
+	if err != nil {
+		return "", fmt.Errorf("Analyzer.ToJSON: cannot convert to JSON: %w", err)
+	}
+	return string(bytes), nil
+}
+
+// Returns the code of the language of the text when detecting the languages and the language is detected, otherwise
+// the language the words are stemmed in. The texts written in the scripts of none of the detected languages are of the
+// language langDetect.Unknown, their words are neither stemmed nor dropped as stopwords
+func (analyzer *Analyzer) DetectLanguage(text string) string {
+	if analyzer.Config.DetectLanguage {
+		if language, ok := langDetect.Detect(text); ok {
+			return language
+		}
+	}
+	return analyzer.Config.stemLanguage()
+}
+
+// Returns the codes of the languages the texts can be analyzed in, i.e. the languages DetectLanguage returns
+func (analyzer *Analyzer) Languages() []string {
+	ret := []string{analyzer.Config.stemLanguage()}
+	for language := range analyzer.filtersByLanguage {
+		if language != ret[0] {
+			ret = append(ret, language)
+		}
+	}
+	return ret
+}
+
+// Returns the tokens of the text in its language, see DetectLanguage
+func (analyzer *Analyzer) Analyze(text string) []Token {
+	return analyzer.AnalyzeIn(text, analyzer.DetectLanguage(text))
+}
+
+// Returns the tokens of the text in the language, every word is followed by its expansions. The language only
+// matters when detecting the languages
+func (analyzer *Analyzer) AnalyzeIn(text string, language string) []Token {
+	return analyzer.analyze(analyzer.newTokenizer(text), language)
+}
+
+// Returns the tokens of the source code in the language, every identifier is followed by its sub-words when the source
+// code is split by the code tokenizer, see tokenizer.SplitIdentifier
+func (analyzer *Analyzer) AnalyzeSourceIn(text string, language string) []Token {
+	return analyzer.analyze(analyzer.newSourceTokenizer(text), language)
+}
+
+func (analyzer *Analyzer) analyze(t tokenizer.Tokenizer, language string) []Token {
+	filters, ok := analyzer.filtersByLanguage[language]
+	if !ok {
+		filters = analyzer.filters
+	}
+	_, splitIdentifiers := t.(*tokenizer.CodeTokenizer)
+	var tokens []Token
+	position := uint(0)
+	for t.Contains() {
+		word := t.NextToken()
+		if word == "" {
+			continue
+		}
+		end := t.Offset()
+		token := Token{Text: word, Position: position, Start: end - len(word), End: end}
+		tokens = append(tokens, token)
+		if splitIdentifiers {
+			for _, subWord := range tokenizer.SplitIdentifier(word) {
+				expansion := token
+				expansion.Text, expansion.Expansion = subWord, true
+				tokens = append(tokens, expansion)
+			}
+		}
+		position++
+	}
+	for _, filter := range filters {
+		tokens = filter.Apply(tokens)
+	}
+	return tokens
+}
+
+// Returns the text with its case and its diacritics normalized by the filters of the analyzer, without splitting it
+// into words nor stemming it, e.g. for matching the patterns of the queries against the tokens
+func (analyzer *Analyzer) Normalize(text string) string {
+	tokens := []Token{{Text: text}}
+	for _, filter := range analyzer.filters {
+		switch filter := filter.(type) {
+		case lowercaseFilter, uppercaseFilter:
+			tokens = filter.Apply(tokens)
+		case foldFilter:
+			tokens = foldFilter{}.Apply(tokens)
+		default:
+		}
+	}
+	return tokens[0].Text
+}
+
+// Returns the words of the text in its language, see DetectLanguage
+func (analyzer *Analyzer) Words(text string) []Token {
+	return analyzer.WordsIn(text, analyzer.DetectLanguage(text))
+}
+
+// Returns the words of the text in the language, i.e. its tokens except the expansions
+func (analyzer *Analyzer) WordsIn(text string, language string) []Token {
+	var ret []Token
+	for _, token := range analyzer.AnalyzeIn(text, language) {
+		if !token.Expansion {
+			ret = append(ret, token)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,430 @@
+package analyzer
+
+import (
+	"fmt"
+	"gosen/stemmer"
+	"gosen/tokenizer"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	SimpleTokenizerName  string = "simple"
+	UnicodeTokenizerName        = "unicode"
+	CodeTokenizerName           = "code"
+)
+
+const (
+	LowercaseFilterType string = "lowercase"
+	UppercaseFilterType        = "uppercase"
+	StopwordsFilterType        = "stopwords"
+	FoldFilterType             = "fold"
+	StemFilterType             = "stem"
+	NGramsFilterType           = "ngrams"
+)
+
+// Configuration of a filter, only the options relevant to the Type of the filter are used
+type FilterConfig struct {
+	Type string `json:"type"`
+	// Code of the language of the stopwords or the stemmer, see stemmer.Languages
+	Language string `json:"language,omitempty"`
+	// Stopwords replacing the built-in stopwords of the language
+	Words []string `json:"words,omitempty"`
+	// Keep the words with diacritics as expansions of the folded words
+	KeepOriginal bool `json:"keepOriginal,omitempty"`
+	// Sizes of the ngrams
+	Sizes []uint `json:"sizes,omitempty"`
+	// Only generate the ngrams prefixing the words
+	Edge bool `json:"edge,omitempty"`
+}
+
+// Named configuration of an analyzer, the filters are applied in order
+type Config struct {
+	Name      string         `json:"name"`
+	Tokenizer string         `json:"tokenizer"`
+	Filters   []FilterConfig `json:"filters"`
+	// Tokenizer of the source code, the code tokenizer also follows every identifier by its sub-words. The source code
+	// is split by Tokenizer when empty
+	SourceTokenizer string `json:"sourceTokenizer,omitempty"`
+	// Detect the language of each text, stemming it and dropping its stopwords in that language. The languages of the
+	// filters are used for the texts whose language is not detected
+	DetectLanguage bool `json:"detectLanguage,omitempty"`
+}
+
+// Language detecting the language of each text
+const AutoLanguage = "auto"
+
+// Returns the code of the language given by its code or its name, AutoLanguage is kept as is
+func LanguageCode(language string) (string, error) {
+	if language == AutoLanguage {
+		return language, nil
+	}
+	return stemmer.LanguageCode(language)
+}
+
+const DefaultConfigName = "default"
+
+var configs = map[string]Config{
+	DefaultConfigName: {
+		Name:            DefaultConfigName,
+		Tokenizer:       UnicodeTokenizerName,
+		SourceTokenizer: CodeTokenizerName,
+		Filters: []FilterConfig{
+			{Type: LowercaseFilterType},
+			{Type: StopwordsFilterType, Language: "en"},
+			{Type: FoldFilterType},
+			{Type: StemFilterType, Language: "en"},
+			{Type: NGramsFilterType, Sizes: []uint{3, 5, 7}},
+		},
+	},
+	// Words as they are, without stemming and ngrams
+	"exact": {
+		Name:            "exact",
+		Tokenizer:       UnicodeTokenizerName,
+		SourceTokenizer: CodeTokenizerName,
+		Filters: []FilterConfig{
+			{Type: LowercaseFilterType},
+			{Type: StopwordsFilterType, Language: "en"},
+		},
+	},
+	// Splits the text at the ASCII whitespaces and punctuations, as the earlier versions of gosen did
+	"simple": {
+		Name:      "simple",
+		Tokenizer: SimpleTokenizerName,
+		Filters: []FilterConfig{
+			{Type: LowercaseFilterType},
+			{Type: StopwordsFilterType, Language: "en"},
+			{Type: FoldFilterType},
+			{Type: StemFilterType, Language: "en"},
+			{Type: NGramsFilterType, Sizes: []uint{3, 5, 7}},
+		},
+	},
+}
+
+// Returns the code of the language the words are stemmed in, empty when the words are not stemmed
+func (config Config) stemLanguage() string {
+	for _, filter := range config.Filters {
+		if filter.Type == StemFilterType {
+			if code, err := stemmer.LanguageCode(filter.Language); err == nil {
+				return code
+			}
+			return filter.Language
+		}
+	}
+	return ""
+}
+
+// Returns the code of the language the words are stemmed in, AutoLanguage when the language of each text is detected
+// and empty when the words are not stemmed
+func (config Config) Language() string {
+	if config.DetectLanguage {
+		return AutoLanguage
+	}
+	return config.stemLanguage()
+}
+
+// Returns the configuration stemming the words and dropping the stopwords in the language given by its code, the
+// stopwords read from a file or of the languages without stopwords are kept. AutoLanguage detects the language of each
+// text
+func (config Config) WithLanguage(language string) (Config, error) {
+	code, err := LanguageCode(language)
+	if err != nil {
+		return Config{}, fmt.Errorf("Config.WithLanguage: %w", err)
+	}
+	config.DetectLanguage = code == AutoLanguage
+	if config.DetectLanguage {
+		return config, nil
+	}
+	filters := make([]FilterConfig, len(config.Filters))
+	copy(filters, config.Filters)
+	for i := range filters {
+		switch filters[i].Type {
+		case StemFilterType:
+			filters[i].Language = code
+		case StopwordsFilterType:
+			if _, ok := stopwordsByLanguage[code]; ok && len(filters[i].Words) == 0 {
+				filters[i].Language = code
+			}
+		default:
+		}
+	}
+	config.Filters = filters
+	return config, nil
+}
+
+// Returns the configuration neither stemming the words nor dropping the built-in stopwords of a language, the stopwords
+// read from a file are kept
+func (config Config) withoutLanguage() Config {
+	config = config.withFilter(FilterConfig{Type: StemFilterType}, false)
+	if filter, ok := config.Stopwords(); ok && len(filter.Words) == 0 {
+		config = config.withStopwordsFilter(filter, false)
+	}
+	return config
+}
+
+// Stopwords value disabling the removal of the stopwords
+const NoStopwords = "none"
+
+// Reads the stopwords from a plain text file listing them separated by whitespaces, usually one per line. The empty
+// lines and the lines starting with `#` are skipped
+func ReadStopwords(filePath string) ([]string, error) {
+	bytes, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("analyzer.ReadStopwords: cannot read the stopwords from `%s`: %w", filePath, err)
+	}
+	ret := []string{}
+	for _, line := range strings.Split(string(bytes), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		ret = append(ret, strings.Fields(line)...)
+	}
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("analyzer.ReadStopwords: no stopwords found in `%s`", filePath)
+	}
+	return ret, nil
+}
+
+// Returns the stopwords filter of the configuration, ok is false when the stopwords are kept
+func (config Config) Stopwords() (filter FilterConfig, ok bool) {
+	for _, filter := range config.Filters {
+		if filter.Type == StopwordsFilterType {
+			return filter, true
+		}
+	}
+	return FilterConfig{}, false
+}
+
+// Returns the description of the stopwords dropped by the configuration
+func (config Config) DescribeStopwords() string {
+	filter, ok := config.Stopwords()
+	switch {
+	case !ok:
+		return "no stopwords"
+	case len(filter.Words) > 0:
+		return fmt.Sprintf("%d custom stopwords", len(filter.Words))
+	case config.DetectLanguage:
+		return "built-in stopwords of the language of each text"
+	default:
+	}
+	return fmt.Sprintf("built-in `%s` stopwords", filter.Language)
+}
+
+// Returns the configuration dropping the stopwords given by the value: NoStopwords keeps all the words, a language
+// selects the built-in stopwords of the language and any other value is the path of a file listing the stopwords
+func (config Config) WithStopwords(value string) (Config, error) {
+	if value == NoStopwords {
+		return config.withStopwordsFilter(FilterConfig{Type: StopwordsFilterType}, false), nil
+	}
+	stopwords := FilterConfig{Type: StopwordsFilterType}
+	if code, err := stemmer.LanguageCode(value); err == nil {
+		if _, ok := stopwordsByLanguage[code]; !ok {
+			return Config{}, fmt.Errorf("Config.WithStopwords: no stopwords for the language `%s`", value)
+		}
+		stopwords.Language = code
+	} else if _, statErr := os.Stat(value); statErr != nil {
+		return Config{}, fmt.Errorf("Config.WithStopwords: `%s` is neither `%s`, a language nor a file of stopwords: %w", value, NoStopwords, err)
+	} else if stopwords.Words, err = ReadStopwords(value); err != nil {
+		return Config{}, fmt.Errorf("Config.WithStopwords: %w", err)
+	}
+	return config.withStopwordsFilter(stopwords, true), nil
+}
+
+// Returns the configuration dropping the same stopwords as the other configuration
+func (config Config) WithStopwordsOf(other Config) Config {
+	filter, ok := other.Stopwords()
+	filter.Type = StopwordsFilterType
+	return config.withStopwordsFilter(filter, ok)
+}
+
+// Replaces the stopwords filter of the configuration by the filter, or just removes it when ok is false. The stopwords
+// are dropped before the words are folded, stemmed or expanded
+func (config Config) withStopwordsFilter(stopwords FilterConfig, ok bool) Config {
+	return config.withFilter(stopwords, ok, FoldFilterType, StemFilterType, NGramsFilterType)
+}
+
+// Replaces the filter of the type of the filter by the filter, or just removes it when ok is false. A new filter is
+// inserted before the first filter of the types following it
+func (config Config) withFilter(filter FilterConfig, ok bool, followingTypes ...string) Config {
+	filters := []FilterConfig{}
+	for _, current := range config.Filters {
+		if current.Type == filter.Type {
+			continue
+		}
+		for _, followingType := range followingTypes {
+			if ok && current.Type == followingType {
+				filters = append(filters, filter)
+				ok = false
+			}
+		}
+		filters = append(filters, current)
+	}
+	if ok {
+		filters = append(filters, filter)
+	}
+	config.Filters = filters
+	return config
+}
+
+// Checks if both configurations drop the same stopwords
+func (config Config) SameStopwords(other Config) bool {
+	filter, ok := config.Stopwords()
+	otherFilter, otherOk := other.Stopwords()
+	return ok == otherOk && reflect.DeepEqual(filter, otherFilter)
+}
+
+// Treatments of the diacritics of the words
+const (
+	// Strip the diacritics, see FoldFilterType
+	FoldAccents string = "fold"
+	// Strip the diacritics and also keep the words with diacritics, ranking the queries with the same diacritics higher
+	BothAccents = "both"
+	// Keep the diacritics
+	KeepAccents = "keep"
+)
+
+// Returns the treatment of the diacritics of the words by the configuration
+func (config Config) Accents() string {
+	for _, filter := range config.Filters {
+		if filter.Type == FoldFilterType {
+			if filter.KeepOriginal {
+				return BothAccents
+			}
+			return FoldAccents
+		}
+	}
+	return KeepAccents
+}
+
+// Returns the configuration treating the diacritics of the words as given by the value, the diacritics are stripped
+// after the stopwords are dropped, before the words are stemmed or expanded
+func (config Config) WithAccents(value string) (Config, error) {
+	switch value {
+	case FoldAccents, BothAccents, KeepAccents:
+	default:
+		return Config{}, fmt.Errorf("Config.WithAccents: unknown accents `%s`, supported accents: [%s, %s, %s]", value, FoldAccents, BothAccents, KeepAccents)
+	}
+	filter := FilterConfig{Type: FoldFilterType, KeepOriginal: value == BothAccents}
+	return config.withFilter(filter, value != KeepAccents, StemFilterType, NGramsFilterType), nil
+}
+
+const (
+	// Ngrams value disabling the ngrams
+	NoNgrams = "none"
+	// Prefix of the ngrams value generating the ngrams prefixing the words
+	edgeNgramsPrefix = "edge:"
+)
+
+// Returns the ngrams generated by the configuration as comma separated sizes prefixed by `edge:` for the ngrams
+// prefixing the words, NoNgrams when no ngrams are generated, see WithNgrams
+func (config Config) Ngrams() string {
+	for _, filter := range config.Filters {
+		if filter.Type == NGramsFilterType {
+			sizes := make([]string, len(filter.Sizes))
+			for i, size := range filter.Sizes {
+				sizes[i] = strconv.FormatUint(uint64(size), 10)
+			}
+			if filter.Edge {
+				return edgeNgramsPrefix + strings.Join(sizes, ",")
+			}
+			return strings.Join(sizes, ",")
+		}
+	}
+	return NoNgrams
+}
+
+// Returns the configuration generating the ngrams given by the value: NoNgrams disables them, comma separated sizes
+// (3,5,7) generate the ngrams of the sizes and the sizes prefixed by `edge:` (edge:2,3,4) only the ngrams prefixing the
+// words. The ngrams are generated after the words are stemmed
+func (config Config) WithNgrams(value string) (Config, error) {
+	filter := FilterConfig{Type: NGramsFilterType}
+	if value != NoNgrams {
+		sizes, edge := strings.CutPrefix(value, edgeNgramsPrefix)
+		filter.Edge = edge
+		for _, size := range strings.Split(sizes, ",") {
+			n, err := strconv.ParseUint(strings.TrimSpace(size), 10, 32)
+			if err != nil || n == 0 {
+				return Config{}, fmt.Errorf("Config.WithNgrams: sizes of the ngrams must be positive integers, got `%s`", value)
+			}
+			filter.Sizes = append(filter.Sizes, uint(n))
+		}
+	}
+	return config.withFilter(filter, value != NoNgrams), nil
+}
+
+// Returns the names of all the named configurations
+func ConfigNames() []string {
+	ret := []string{}
+	for name := range configs {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Returns the named configuration
+func LookupConfig(name string) (Config, error) {
+	config, ok := configs[name]
+	if !ok {
+		return Config{}, fmt.Errorf("analyzer.LookupConfig: unknown analyzer `%s`, supported analyzers: %v", name, ConfigNames())
+	}
+	return config, nil
+}
+
+func mkTokenizer(name string) (func(text string) tokenizer.Tokenizer, error) {
+	switch name {
+	case SimpleTokenizerName:
+		return func(text string) tokenizer.Tokenizer { return tokenizer.SimpleTokenizerFromString(text) }, nil
+	case UnicodeTokenizerName:
+		return func(text string) tokenizer.Tokenizer { return tokenizer.UnicodeTokenizerFromString(text) }, nil
+	case CodeTokenizerName:
+		return func(text string) tokenizer.Tokenizer { return tokenizer.CodeTokenizerFromString(text) }, nil
+	default:
+	}
+	return nil, fmt.Errorf("unknown tokenizer `%s`, supported tokenizers: [%s, %s, %s]", name, SimpleTokenizerName, UnicodeTokenizerName, CodeTokenizerName)
+}
+
+func mkFilter(config FilterConfig) (Filter, error) {
+	switch config.Type {
+	case LowercaseFilterType:
+		return lowercaseFilter{}, nil
+	case UppercaseFilterType:
+		return uppercaseFilter{}, nil
+	case StopwordsFilterType:
+		if len(config.Words) > 0 {
+			stopwords := map[string]bool{}
+			for _, word := range config.Words {
+				stopwords[strings.ToUpper(word)] = true
+			}
+			return stopwordsFilter{stopwords: stopwords}, nil
+		}
+		language, err := stemmer.LanguageCode(config.Language)
+		if err != nil {
+			return nil, err
+		}
+		stopwords, ok := stopwordsByLanguage[language]
+		if !ok {
+			return nil, fmt.Errorf("no stopwords for the language `%s`", config.Language)
+		}
+		return stopwordsFilter{stopwords: stopwords}, nil
+	case FoldFilterType:
+		return foldFilter{keepOriginal: config.KeepOriginal}, nil
+	case StemFilterType:
+		if _, err := stemmer.New(config.Language); err != nil {
+			return nil, err
+		}
+		return stemFilter{language: config.Language}, nil
+	case NGramsFilterType:
+		for _, size := range config.Sizes {
+			if size == 0 {
+				return nil, fmt.Errorf("sizes of the ngrams must be positive, got %v", config.Sizes)
+			}
+		}
+		return ngramsFilter{sizes: config.Sizes, edge: config.Edge}, nil
+	default:
+	}
+	return nil, fmt.Errorf("unknown filter `%s`, supported filters: [%s, %s, %s, %s, %s, %s]", config.Type, LowercaseFilterType, UppercaseFilterType, StopwordsFilterType, FoldFilterType, StemFilterType, NGramsFilterType)
+}
@@ -0,0 +1,161 @@
+package analyzer
+
+import (
+	"gosen/stemmer"
+	"gosen/tokenizer"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Stopwords keyed by the codes of their languages
+var stopwordsByLanguage = map[string]map[string]bool{
+	"en": EnglishStopwords,
+	"fr": FrenchStopwords,
+	"de": GermanStopwords,
+	"es": SpanishStopwords,
+	"it": ItalianStopwords,
+	"pt": PortugueseStopwords,
+	"nl": DutchStopwords,
+	"ru": RussianStopwords,
+}
+
+// Lowercases the tokens, also normalizes the typographic apostrophes (’) to the ASCII ones
+type lowercaseFilter struct{}
+
+func (lowercaseFilter) Apply(tokens []Token) []Token {
+	for i := range tokens {
+		tokens[i].Text = strings.ToLower(strings.ReplaceAll(tokens[i].Text, "’", "'"))
+	}
+	return tokens
+}
+
+// Uppercases the tokens, also normalizes the typographic apostrophes (’) to the ASCII ones
+type uppercaseFilter struct{}
+
+func (uppercaseFilter) Apply(tokens []Token) []Token {
+	for i := range tokens {
+		tokens[i].Text = strings.ToUpper(strings.ReplaceAll(tokens[i].Text, "’", "'"))
+	}
+	return tokens
+}
+
+// Drops the stopwords, the positions of the remaining tokens are kept so phrases do not match across the stopwords
+type stopwordsFilter struct {
+	stopwords map[string]bool
+}
+
+func (filter stopwordsFilter) Apply(tokens []Token) []Token {
+	ret := tokens[:0]
+	for _, token := range tokens {
+		if !filter.stopwords[strings.ToUpper(token.Text)] {
+			ret = append(ret, token)
+		}
+	}
+	return ret
+}
+
+// Checks if the rune is a combining diacritical mark, e.g. the acute accent of a decomposed é (e followed by U+0301)
+func isDiacritic(r rune) bool {
+	return unicode.In(r, unicode.Mn) && (r >= 0x0300 && r <= 0x036F || r >= 0x1AB0 && r <= 0x1AFF ||
+		r >= 0x1DC0 && r <= 0x1DFF || r >= 0x20D0 && r <= 0x20FF || r >= 0xFE20 && r <= 0xFE2F)
+}
+
+// Returns the text without the diacritics of its Latin and Greek letters, see foldings
+func fold(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		if isDiacritic(r) {
+			continue
+		}
+		if folded, ok := foldings[r]; ok {
+			sb.WriteString(folded)
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// Strips the diacritics of the words (résumé gives resume, naïve gives naive), optionally keeping the original words as
+// expansions so the queries with the same diacritics rank higher
+type foldFilter struct {
+	keepOriginal bool
+}
+
+func (filter foldFilter) Apply(tokens []Token) []Token {
+	var ret []Token
+	for _, token := range tokens {
+		folded := token
+		folded.Text = fold(token.Text)
+		ret = append(ret, folded)
+		if filter.keepOriginal && !token.Expansion && folded.Text != token.Text {
+			token.Expansion = true
+			ret = append(ret, token)
+		}
+	}
+	return ret
+}
+
+// Replaces the words by their stems. The rules of the stemmers are lowercase, hence the words are stemmed lowercased
+// and the stems of the uppercase words are uppercased back. The expansions preceding the stemmer (e.g. the sub-words of
+// the identifiers) are stemmed as well, so they match the stemmed words of the queries
+type stemFilter struct {
+	// The stemmers keep state while stemming, hence a stemmer is created for every call of Apply
+	language string
+}
+
+func (filter stemFilter) Apply(tokens []Token) []Token {
+	stem, _ := stemmer.New(filter.language)
+	for i := range tokens {
+		text := tokens[i].Text
+		stemmed := stem.Stem(strings.ToLower(text))
+		if text != strings.ToLower(text) && text == strings.ToUpper(text) {
+			stemmed = strings.ToUpper(stemmed)
+		}
+		tokens[i].Text = stemmed
+	}
+	return tokens
+}
+
+// Returns the ngrams of the token of the size, i.e. its runs of n runes, only its prefix when edge is set
+func ngrams(token string, n uint, edge bool) []string {
+	var ret []string
+	runes := []rune(token)
+	for i := uint(0); i+n <= uint(len(runes)); i++ {
+		ret = append(ret, string(runes[i:i+n]))
+		if edge {
+			break
+		}
+	}
+	return ret
+}
+
+// Follows every word by its ngrams of each of the sizes, as expansions sharing the position and the offsets of the word.
+// Only the prefixes of the words are generated when edge is set. The ngrams spanning the whole words are skipped, as are
+// the ngrams of the CJK words which are bigrams already, see tokenizer.IsCJK
+type ngramsFilter struct {
+	sizes []uint
+	edge  bool
+}
+
+func (filter ngramsFilter) Apply(tokens []Token) []Token {
+	var ret []Token
+	for _, token := range tokens {
+		ret = append(ret, token)
+		if r, _ := utf8.DecodeRuneInString(token.Text); token.Expansion || tokenizer.IsCJK(r) {
+			continue
+		}
+		for _, size := range filter.sizes {
+			for _, ngram := range ngrams(token.Text, size, filter.edge) {
+				if ngram == token.Text {
+					continue
+				}
+				expansion := token
+				expansion.Text, expansion.Expansion, expansion.Ngram = ngram, true, true
+				ret = append(ret, expansion)
+			}
+		}
+	}
+	return ret
+}
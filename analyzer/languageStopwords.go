@@ -0,0 +1,1122 @@
+package analyzer
+
+// French stopwords taken from the Snowball stop word list: https://snowballstem.org/algorithms/
+var FrenchStopwords = map[string]bool{
+	"AI":       true,
+	"AIE":      true,
+	"AIENT":    true,
+	"AIES":     true,
+	"AIT":      true,
+	"AS":       true,
+	"AU":       true,
+	"AURA":     true,
+	"AURAI":    true,
+	"AURAIENT": true,
+	"AURAIS":   true,
+	"AURAIT":   true,
+	"AURAS":    true,
+	"AUREZ":    true,
+	"AURIEZ":   true,
+	"AURIONS":  true,
+	"AURONS":   true,
+	"AURONT":   true,
+	"AUX":      true,
+	"AVAIENT":  true,
+	"AVAIS":    true,
+	"AVAIT":    true,
+	"AVEC":     true,
+	"AVEZ":     true,
+	"AVIEZ":    true,
+	"AVIONS":   true,
+	"AVONS":    true,
+	"AYANT":    true,
+	"AYEZ":     true,
+	"AYONS":    true,
+	"C":        true,
+	"CE":       true,
+	"CECI":     true,
+	"CELA":     true,
+	"CES":      true,
+	"CET":      true,
+	"CETTE":    true,
+	"D":        true,
+	"DANS":     true,
+	"DE":       true,
+	"DES":      true,
+	"DU":       true,
+	"ELLE":     true,
+	"EN":       true,
+	"ES":       true,
+	"EST":      true,
+	"ET":       true,
+	"EU":       true,
+	"EUE":      true,
+	"EUES":     true,
+	"EURENT":   true,
+	"EUS":      true,
+	"EUSSE":    true,
+	"EUSSENT":  true,
+	"EUSSES":   true,
+	"EUSSIEZ":  true,
+	"EUSSIONS": true,
+	"EUT":      true,
+	"EUX":      true,
+	"EÛMES":    true,
+	"EÛT":      true,
+	"EÛTES":    true,
+	"FURENT":   true,
+	"FUS":      true,
+	"FUSSE":    true,
+	"FUSSENT":  true,
+	"FUSSES":   true,
+	"FUSSIEZ":  true,
+	"FUSSIONS": true,
+	"FUT":      true,
+	"FÛMES":    true,
+	"FÛT":      true,
+	"FÛTES":    true,
+	"ICI":      true,
+	"IL":       true,
+	"ILS":      true,
+	"J":        true,
+	"JE":       true,
+	"L":        true,
+	"LA":       true,
+	"LE":       true,
+	"LES":      true,
+	"LEUR":     true,
+	"LEURS":    true,
+	"LUI":      true,
+	"M":        true,
+	"MA":       true,
+	"MAIS":     true,
+	"ME":       true,
+	"MES":      true,
+	"MOI":      true,
+	"MON":      true,
+	"MÊME":     true,
+	"N":        true,
+	"NE":       true,
+	"NOS":      true,
+	"NOTRE":    true,
+	"NOUS":     true,
+	"ON":       true,
+	"ONT":      true,
+	"OU":       true,
+	"PAR":      true,
+	"PAS":      true,
+	"POUR":     true,
+	"QU":       true,
+	"QUE":      true,
+	"QUEL":     true,
+	"QUELLE":   true,
+	"QUELLES":  true,
+	"QUELS":    true,
+	"QUI":      true,
+	"S":        true,
+	"SA":       true,
+	"SANS":     true,
+	"SE":       true,
+	"SERA":     true,
+	"SERAI":    true,
+	"SERAIENT": true,
+	"SERAIS":   true,
+	"SERAIT":   true,
+	"SERAS":    true,
+	"SEREZ":    true,
+	"SERIEZ":   true,
+	"SERIONS":  true,
+	"SERONS":   true,
+	"SERONT":   true,
+	"SES":      true,
+	"SOI":      true,
+	"SOIENT":   true,
+	"SOIS":     true,
+	"SOIT":     true,
+	"SOMMES":   true,
+	"SON":      true,
+	"SONT":     true,
+	"SOYEZ":    true,
+	"SOYONS":   true,
+	"SUIS":     true,
+	"SUR":      true,
+	"T":        true,
+	"TA":       true,
+	"TE":       true,
+	"TES":      true,
+	"TOI":      true,
+	"TON":      true,
+	"TU":       true,
+	"UN":       true,
+	"UNE":      true,
+	"VOS":      true,
+	"VOTRE":    true,
+	"VOUS":     true,
+	"Y":        true,
+	"À":        true,
+	"ÉTAIENT":  true,
+	"ÉTAIS":    true,
+	"ÉTAIT":    true,
+	"ÉTANT":    true,
+	"ÉTIEZ":    true,
+	"ÉTIONS":   true,
+	"ÉTÉ":      true,
+	"ÉTÉE":     true,
+	"ÉTÉES":    true,
+	"ÉTÉS":     true,
+	"ÊTES":     true,
+}
+
+// German stopwords taken from the Snowball stop word list: https://snowballstem.org/algorithms/
+var GermanStopwords = map[string]bool{
+	"ABER":      true,
+	"ALLE":      true,
+	"ALLEM":     true,
+	"ALLEN":     true,
+	"ALLER":     true,
+	"ALLES":     true,
+	"ALS":       true,
+	"ALSO":      true,
+	"AM":        true,
+	"AN":        true,
+	"ANDER":     true,
+	"ANDERE":    true,
+	"ANDEREM":   true,
+	"ANDEREN":   true,
+	"ANDERER":   true,
+	"ANDERES":   true,
+	"ANDERM":    true,
+	"ANDERN":    true,
+	"ANDERS":    true,
+	"AUCH":      true,
+	"AUF":       true,
+	"AUS":       true,
+	"BEI":       true,
+	"BIN":       true,
+	"BIS":       true,
+	"BIST":      true,
+	"DA":        true,
+	"DAMIT":     true,
+	"DANN":      true,
+	"DAS":       true,
+	"DASS":      true,
+	"DAß":       true,
+	"DASSELBE":  true,
+	"DAZU":      true,
+	"DEIN":      true,
+	"DEINE":     true,
+	"DEINEM":    true,
+	"DEINEN":    true,
+	"DEINER":    true,
+	"DEINES":    true,
+	"DEM":       true,
+	"DEMSELBEN": true,
+	"DEN":       true,
+	"DENN":      true,
+	"DENSELBEN": true,
+	"DER":       true,
+	"DERER":     true,
+	"DERSELBE":  true,
+	"DERSELBEN": true,
+	"DES":       true,
+	"DESSELBEN": true,
+	"DESSEN":    true,
+	"DICH":      true,
+	"DIE":       true,
+	"DIES":      true,
+	"DIESE":     true,
+	"DIESELBE":  true,
+	"DIESELBEN": true,
+	"DIESEM":    true,
+	"DIESEN":    true,
+	"DIESER":    true,
+	"DIESES":    true,
+	"DIR":       true,
+	"DOCH":      true,
+	"DORT":      true,
+	"DU":        true,
+	"DURCH":     true,
+	"EIN":       true,
+	"EINE":      true,
+	"EINEM":     true,
+	"EINEN":     true,
+	"EINER":     true,
+	"EINES":     true,
+	"EINIG":     true,
+	"EINIGE":    true,
+	"EINIGEM":   true,
+	"EINIGEN":   true,
+	"EINIGER":   true,
+	"EINIGES":   true,
+	"EINMAL":    true,
+	"ER":        true,
+	"ES":        true,
+	"ETWAS":     true,
+	"EUCH":      true,
+	"EUER":      true,
+	"EURE":      true,
+	"EUREM":     true,
+	"EUREN":     true,
+	"EURER":     true,
+	"EURES":     true,
+	"FÜR":       true,
+	"GEGEN":     true,
+	"GEWESEN":   true,
+	"HAB":       true,
+	"HABE":      true,
+	"HABEN":     true,
+	"HAT":       true,
+	"HATTE":     true,
+	"HATTEN":    true,
+	"HIER":      true,
+	"HIN":       true,
+	"HINTER":    true,
+	"ICH":       true,
+	"IHM":       true,
+	"IHN":       true,
+	"IHNEN":     true,
+	"IHR":       true,
+	"IHRE":      true,
+	"IHREM":     true,
+	"IHREN":     true,
+	"IHRER":     true,
+	"IHRES":     true,
+	"IM":        true,
+	"IN":        true,
+	"INDEM":     true,
+	"INS":       true,
+	"IST":       true,
+	"JEDE":      true,
+	"JEDEM":     true,
+	"JEDEN":     true,
+	"JEDER":     true,
+	"JEDES":     true,
+	"JENE":      true,
+	"JENEM":     true,
+	"JENEN":     true,
+	"JENER":     true,
+	"JENES":     true,
+	"JETZT":     true,
+	"KANN":      true,
+	"KEIN":      true,
+	"KEINE":     true,
+	"KEINEM":    true,
+	"KEINEN":    true,
+	"KEINER":    true,
+	"KEINES":    true,
+	"KÖNNEN":    true,
+	"KÖNNTE":    true,
+	"MACHEN":    true,
+	"MAN":       true,
+	"MANCHE":    true,
+	"MANCHEM":   true,
+	"MANCHEN":   true,
+	"MANCHER":   true,
+	"MANCHES":   true,
+	"MEIN":      true,
+	"MEINE":     true,
+	"MEINEM":    true,
+	"MEINEN":    true,
+	"MEINER":    true,
+	"MEINES":    true,
+	"MICH":      true,
+	"MIR":       true,
+	"MIT":       true,
+	"MUSS":      true,
+	"MUSSTE":    true,
+	"NACH":      true,
+	"NICHT":     true,
+	"NICHTS":    true,
+	"NOCH":      true,
+	"NUN":       true,
+	"NUR":       true,
+	"OB":        true,
+	"ODER":      true,
+	"OHNE":      true,
+	"SEHR":      true,
+	"SEIN":      true,
+	"SEINE":     true,
+	"SEINEM":    true,
+	"SEINEN":    true,
+	"SEINER":    true,
+	"SEINES":    true,
+	"SELBST":    true,
+	"SICH":      true,
+	"SIE":       true,
+	"SIND":      true,
+	"SO":        true,
+	"SOLCHE":    true,
+	"SOLCHEM":   true,
+	"SOLCHEN":   true,
+	"SOLCHER":   true,
+	"SOLCHES":   true,
+	"SOLL":      true,
+	"SOLLTE":    true,
+	"SONDERN":   true,
+	"SONST":     true,
+	"UM":        true,
+	"UND":       true,
+	"UNS":       true,
+	"UNSER":     true,
+	"UNSERE":    true,
+	"UNSEREM":   true,
+	"UNSEREN":   true,
+	"UNSERES":   true,
+	"UNTER":     true,
+	"VIEL":      true,
+	"VOM":       true,
+	"VON":       true,
+	"VOR":       true,
+	"WAR":       true,
+	"WAREN":     true,
+	"WARST":     true,
+	"WAS":       true,
+	"WEG":       true,
+	"WEIL":      true,
+	"WEITER":    true,
+	"WELCHE":    true,
+	"WELCHEM":   true,
+	"WELCHEN":   true,
+	"WELCHER":   true,
+	"WELCHES":   true,
+	"WENN":      true,
+	"WERDE":     true,
+	"WERDEN":    true,
+	"WIE":       true,
+	"WIEDER":    true,
+	"WILL":      true,
+	"WIR":       true,
+	"WIRD":      true,
+	"WIRST":     true,
+	"WO":        true,
+	"WOLLEN":    true,
+	"WOLLTE":    true,
+	"WÄHREND":   true,
+	"WÜRDE":     true,
+	"WÜRDEN":    true,
+	"ZU":        true,
+	"ZUM":       true,
+	"ZUR":       true,
+	"ZWAR":      true,
+	"ZWISCHEN":  true,
+	"ÜBER":      true,
+}
+
+// Spanish stopwords taken from the Snowball stop word list: https://snowballstem.org/algorithms/
+var SpanishStopwords = map[string]bool{
+	"A":        true,
+	"AL":       true,
+	"ALGO":     true,
+	"ALGUNAS":  true,
+	"ALGUNOS":  true,
+	"ANTE":     true,
+	"ANTES":    true,
+	"COMO":     true,
+	"CON":      true,
+	"CONTRA":   true,
+	"CUAL":     true,
+	"CUANDO":   true,
+	"DE":       true,
+	"DEL":      true,
+	"DESDE":    true,
+	"DONDE":    true,
+	"DURANTE":  true,
+	"E":        true,
+	"EL":       true,
+	"ELLA":     true,
+	"ELLAS":    true,
+	"ELLOS":    true,
+	"EN":       true,
+	"ENTRE":    true,
+	"ERA":      true,
+	"ERAN":     true,
+	"ERES":     true,
+	"ES":       true,
+	"ESA":      true,
+	"ESAS":     true,
+	"ESE":      true,
+	"ESO":      true,
+	"ESOS":     true,
+	"ESTA":     true,
+	"ESTABA":   true,
+	"ESTABAN":  true,
+	"ESTAMOS":  true,
+	"ESTAR":    true,
+	"ESTARÁ":   true,
+	"ESTARÉ":   true,
+	"ESTAS":    true,
+	"ESTE":     true,
+	"ESTEMOS":  true,
+	"ESTO":     true,
+	"ESTOS":    true,
+	"ESTOY":    true,
+	"ESTUVE":   true,
+	"ESTUVO":   true,
+	"ESTÁ":     true,
+	"ESTÁIS":   true,
+	"ESTÁN":    true,
+	"ESTÁS":    true,
+	"ESTÉ":     true,
+	"ESTÉIS":   true,
+	"ESTÉN":    true,
+	"ESTÉS":    true,
+	"FUE":      true,
+	"FUERON":   true,
+	"HA":       true,
+	"HABÉIS":   true,
+	"HABÍA":    true,
+	"HABÍAN":   true,
+	"HAN":      true,
+	"HAS":      true,
+	"HASTA":    true,
+	"HAY":      true,
+	"HE":       true,
+	"HEMOS":    true,
+	"HUBE":     true,
+	"HUBO":     true,
+	"LA":       true,
+	"LAS":      true,
+	"LE":       true,
+	"LES":      true,
+	"LO":       true,
+	"LOS":      true,
+	"ME":       true,
+	"MI":       true,
+	"MIS":      true,
+	"MUCHO":    true,
+	"MUCHOS":   true,
+	"MUY":      true,
+	"MÁS":      true,
+	"MÍ":       true,
+	"MÍA":      true,
+	"MÍAS":     true,
+	"MÍO":      true,
+	"MÍOS":     true,
+	"NADA":     true,
+	"NI":       true,
+	"NO":       true,
+	"NOS":      true,
+	"NOSOTRAS": true,
+	"NOSOTROS": true,
+	"NUESTRA":  true,
+	"NUESTRAS": true,
+	"NUESTRO":  true,
+	"NUESTROS": true,
+	"O":        true,
+	"OS":       true,
+	"OTRA":     true,
+	"OTRAS":    true,
+	"OTRO":     true,
+	"OTROS":    true,
+	"PARA":     true,
+	"PERO":     true,
+	"POCO":     true,
+	"POR":      true,
+	"PORQUE":   true,
+	"QUE":      true,
+	"QUIEN":    true,
+	"QUIENES":  true,
+	"QUÉ":      true,
+	"SE":       true,
+	"SEA":      true,
+	"SEAN":     true,
+	"SER":      true,
+	"SIN":      true,
+	"SOBRE":    true,
+	"SOIS":     true,
+	"SOMOS":    true,
+	"SON":      true,
+	"SOY":      true,
+	"SU":       true,
+	"SUS":      true,
+	"SUYA":     true,
+	"SUYAS":    true,
+	"SUYO":     true,
+	"SUYOS":    true,
+	"SÍ":       true,
+	"TAMBIÉN":  true,
+	"TANTO":    true,
+	"TE":       true,
+	"TENEMOS":  true,
+	"TENGO":    true,
+	"TENÉIS":   true,
+	"TENÍA":    true,
+	"TENÍAN":   true,
+	"TI":       true,
+	"TIENE":    true,
+	"TIENEN":   true,
+	"TIENES":   true,
+	"TODO":     true,
+	"TODOS":    true,
+	"TU":       true,
+	"TUS":      true,
+	"TUVE":     true,
+	"TUVO":     true,
+	"TUYA":     true,
+	"TUYAS":    true,
+	"TUYO":     true,
+	"TUYOS":    true,
+	"TÚ":       true,
+	"UN":       true,
+	"UNA":      true,
+	"UNO":      true,
+	"UNOS":     true,
+	"VOSOTRAS": true,
+	"VOSOTROS": true,
+	"VUESTRA":  true,
+	"VUESTRAS": true,
+	"VUESTRO":  true,
+	"VUESTROS": true,
+	"Y":        true,
+	"YA":       true,
+	"YO":       true,
+	"ÉL":       true,
+}
+
+// Italian stopwords taken from the Snowball stop word list: https://snowballstem.org/algorithms/
+var ItalianStopwords = map[string]bool{
+	"A":       true,
+	"ABBIA":   true,
+	"ABBIAMO": true,
+	"AD":      true,
+	"AGL":     true,
+	"AGLI":    true,
+	"AI":      true,
+	"AL":      true,
+	"ALL":     true,
+	"ALLA":    true,
+	"ALLE":    true,
+	"ALLO":    true,
+	"ANCHE":   true,
+	"AVETE":   true,
+	"AVEVA":   true,
+	"AVEVANO": true,
+	"AVEVO":   true,
+	"C":       true,
+	"CHE":     true,
+	"CHI":     true,
+	"CI":      true,
+	"COI":     true,
+	"COL":     true,
+	"COME":    true,
+	"CON":     true,
+	"CONTRO":  true,
+	"CUI":     true,
+	"DA":      true,
+	"DAGL":    true,
+	"DAGLI":   true,
+	"DAI":     true,
+	"DAL":     true,
+	"DALL":    true,
+	"DALLA":   true,
+	"DALLE":   true,
+	"DALLO":   true,
+	"DEGL":    true,
+	"DEGLI":   true,
+	"DEI":     true,
+	"DEL":     true,
+	"DELL":    true,
+	"DELLA":   true,
+	"DELLE":   true,
+	"DELLO":   true,
+	"DI":      true,
+	"DOV":     true,
+	"DOVE":    true,
+	"E":       true,
+	"ED":      true,
+	"ERA":     true,
+	"ERANO":   true,
+	"ESSERE":  true,
+	"FU":      true,
+	"FUI":     true,
+	"FURONO":  true,
+	"GLI":     true,
+	"HA":      true,
+	"HAI":     true,
+	"HANNO":   true,
+	"HO":      true,
+	"I":       true,
+	"IL":      true,
+	"IN":      true,
+	"IO":      true,
+	"L":       true,
+	"LA":      true,
+	"LE":      true,
+	"LEI":     true,
+	"LI":      true,
+	"LO":      true,
+	"LORO":    true,
+	"LUI":     true,
+	"MA":      true,
+	"MI":      true,
+	"MIA":     true,
+	"MIE":     true,
+	"MIEI":    true,
+	"MIO":     true,
+	"NE":      true,
+	"NEGL":    true,
+	"NEGLI":   true,
+	"NEI":     true,
+	"NEL":     true,
+	"NELL":    true,
+	"NELLA":   true,
+	"NELLE":   true,
+	"NELLO":   true,
+	"NOI":     true,
+	"NON":     true,
+	"NOSTRA":  true,
+	"NOSTRE":  true,
+	"NOSTRI":  true,
+	"NOSTRO":  true,
+	"O":       true,
+	"PER":     true,
+	"PERCHÉ":  true,
+	"PIÙ":     true,
+	"QUALE":   true,
+	"QUANTA":  true,
+	"QUANTE":  true,
+	"QUANTI":  true,
+	"QUANTO":  true,
+	"QUELLA":  true,
+	"QUELLE":  true,
+	"QUELLI":  true,
+	"QUELLO":  true,
+	"QUESTA":  true,
+	"QUESTE":  true,
+	"QUESTI":  true,
+	"QUESTO":  true,
+	"SARÀ":    true,
+	"SE":      true,
+	"SEI":     true,
+	"SI":      true,
+	"SIA":     true,
+	"SIAMO":   true,
+	"SIETE":   true,
+	"SONO":    true,
+	"SU":      true,
+	"SUA":     true,
+	"SUE":     true,
+	"SUGL":    true,
+	"SUGLI":   true,
+	"SUI":     true,
+	"SUL":     true,
+	"SULL":    true,
+	"SULLA":   true,
+	"SULLE":   true,
+	"SULLO":   true,
+	"SUO":     true,
+	"SUOI":    true,
+	"TI":      true,
+	"TRA":     true,
+	"TU":      true,
+	"TUA":     true,
+	"TUE":     true,
+	"TUO":     true,
+	"TUOI":    true,
+	"TUTTI":   true,
+	"TUTTO":   true,
+	"UN":      true,
+	"UNA":     true,
+	"UNO":     true,
+	"VI":      true,
+	"VOI":     true,
+	"VOSTRA":  true,
+	"VOSTRE":  true,
+	"VOSTRI":  true,
+	"VOSTRO":  true,
+	"È":       true,
+}
+
+// Portuguese stopwords taken from the Snowball stop word list: https://snowballstem.org/algorithms/
+var PortugueseStopwords = map[string]bool{
+	"A":       true,
+	"AO":      true,
+	"AOS":     true,
+	"AQUELA":  true,
+	"AQUELAS": true,
+	"AQUELE":  true,
+	"AQUELES": true,
+	"AQUILO":  true,
+	"AS":      true,
+	"ATÉ":     true,
+	"COM":     true,
+	"COMO":    true,
+	"DA":      true,
+	"DAS":     true,
+	"DE":      true,
+	"DELA":    true,
+	"DELAS":   true,
+	"DELE":    true,
+	"DELES":   true,
+	"DEPOIS":  true,
+	"DO":      true,
+	"DOS":     true,
+	"E":       true,
+	"ELA":     true,
+	"ELAS":    true,
+	"ELE":     true,
+	"ELES":    true,
+	"EM":      true,
+	"ENTRE":   true,
+	"ERA":     true,
+	"ERAM":    true,
+	"ESSA":    true,
+	"ESSAS":   true,
+	"ESSE":    true,
+	"ESSES":   true,
+	"ESTA":    true,
+	"ESTAMOS": true,
+	"ESTAS":   true,
+	"ESTAVA":  true,
+	"ESTAVAM": true,
+	"ESTE":    true,
+	"ESTES":   true,
+	"ESTEVE":  true,
+	"ESTIVE":  true,
+	"ESTOU":   true,
+	"ESTÁ":    true,
+	"ESTÃO":   true,
+	"EU":      true,
+	"FOI":     true,
+	"FORAM":   true,
+	"FUI":     true,
+	"HAVIA":   true,
+	"HOUVE":   true,
+	"HÁ":      true,
+	"ISSO":    true,
+	"ISTO":    true,
+	"JÁ":      true,
+	"LHE":     true,
+	"LHES":    true,
+	"MAIS":    true,
+	"MAS":     true,
+	"ME":      true,
+	"MESMO":   true,
+	"MEU":     true,
+	"MEUS":    true,
+	"MINHA":   true,
+	"MINHAS":  true,
+	"MUITO":   true,
+	"NA":      true,
+	"NAS":     true,
+	"NEM":     true,
+	"NO":      true,
+	"NOS":     true,
+	"NOSSA":   true,
+	"NOSSAS":  true,
+	"NOSSO":   true,
+	"NOSSOS":  true,
+	"NUM":     true,
+	"NUMA":    true,
+	"NÃO":     true,
+	"NÓS":     true,
+	"O":       true,
+	"OS":      true,
+	"OU":      true,
+	"PARA":    true,
+	"PELA":    true,
+	"PELAS":   true,
+	"PELO":    true,
+	"PELOS":   true,
+	"POR":     true,
+	"QUAL":    true,
+	"QUANDO":  true,
+	"QUE":     true,
+	"QUEM":    true,
+	"SE":      true,
+	"SEJA":    true,
+	"SEJAM":   true,
+	"SEM":     true,
+	"SER":     true,
+	"SEU":     true,
+	"SEUS":    true,
+	"SOMOS":   true,
+	"SOU":     true,
+	"SUA":     true,
+	"SUAS":    true,
+	"SÃO":     true,
+	"SÓ":      true,
+	"TAMBÉM":  true,
+	"TE":      true,
+	"TEM":     true,
+	"TEMOS":   true,
+	"TENHO":   true,
+	"TEU":     true,
+	"TEUS":    true,
+	"TEVE":    true,
+	"TINHA":   true,
+	"TINHAM":  true,
+	"TIVE":    true,
+	"TU":      true,
+	"TUA":     true,
+	"TUAS":    true,
+	"TÊM":     true,
+	"UM":      true,
+	"UMA":     true,
+	"VOCÊ":    true,
+	"VOCÊS":   true,
+	"VOS":     true,
+	"À":       true,
+	"ÀS":      true,
+	"É":       true,
+}
+
+// Dutch stopwords taken from the Snowball stop word list: https://snowballstem.org/algorithms/
+var DutchStopwords = map[string]bool{
+	"AAN":     true,
+	"AL":      true,
+	"ALLES":   true,
+	"ALS":     true,
+	"ALTIJD":  true,
+	"ANDERE":  true,
+	"BEN":     true,
+	"BIJ":     true,
+	"DAAR":    true,
+	"DAN":     true,
+	"DAT":     true,
+	"DE":      true,
+	"DER":     true,
+	"DEZE":    true,
+	"DIE":     true,
+	"DIT":     true,
+	"DOCH":    true,
+	"DOEN":    true,
+	"DOOR":    true,
+	"DUS":     true,
+	"EEN":     true,
+	"EENS":    true,
+	"EN":      true,
+	"ER":      true,
+	"GE":      true,
+	"GEEN":    true,
+	"GEWEEST": true,
+	"HAAR":    true,
+	"HAD":     true,
+	"HEB":     true,
+	"HEBBEN":  true,
+	"HEEFT":   true,
+	"HEM":     true,
+	"HET":     true,
+	"HIER":    true,
+	"HIJ":     true,
+	"HOE":     true,
+	"HUN":     true,
+	"IEMAND":  true,
+	"IETS":    true,
+	"IK":      true,
+	"IN":      true,
+	"IS":      true,
+	"JA":      true,
+	"JE":      true,
+	"KAN":     true,
+	"KON":     true,
+	"KUNNEN":  true,
+	"MAAR":    true,
+	"ME":      true,
+	"MEER":    true,
+	"MEN":     true,
+	"MET":     true,
+	"MIJ":     true,
+	"MIJN":    true,
+	"MOET":    true,
+	"NA":      true,
+	"NAAR":    true,
+	"NIET":    true,
+	"NIETS":   true,
+	"NOG":     true,
+	"NU":      true,
+	"OF":      true,
+	"OM":      true,
+	"OMDAT":   true,
+	"ONDER":   true,
+	"ONS":     true,
+	"OOK":     true,
+	"OP":      true,
+	"OVER":    true,
+	"REEDS":   true,
+	"TE":      true,
+	"TEGEN":   true,
+	"TOCH":    true,
+	"TOEN":    true,
+	"TOT":     true,
+	"U":       true,
+	"UIT":     true,
+	"UW":      true,
+	"VAN":     true,
+	"VEEL":    true,
+	"VOOR":    true,
+	"WANT":    true,
+	"WAREN":   true,
+	"WAS":     true,
+	"WAT":     true,
+	"WERD":    true,
+	"WEZEN":   true,
+	"WIE":     true,
+	"WIL":     true,
+	"WORDEN":  true,
+	"WORDT":   true,
+	"ZAL":     true,
+	"ZE":      true,
+	"ZELF":    true,
+	"ZICH":    true,
+	"ZIJ":     true,
+	"ZIJN":    true,
+	"ZO":      true,
+	"ZONDER":  true,
+	"ZOU":     true,
+}
+
+// Russian stopwords taken from the Snowball stop word list: https://snowballstem.org/algorithms/
+var RussianStopwords = map[string]bool{
+	"А":       true,
+	"БЕЗ":     true,
+	"БОЛЕЕ":   true,
+	"БОЛЬШЕ":  true,
+	"БУДЕТ":   true,
+	"БУДТО":   true,
+	"БЫ":      true,
+	"БЫЛ":     true,
+	"БЫЛА":    true,
+	"БЫЛИ":    true,
+	"БЫЛО":    true,
+	"БЫТЬ":    true,
+	"В":       true,
+	"ВАМ":     true,
+	"ВАС":     true,
+	"ВДРУГ":   true,
+	"ВЕДЬ":    true,
+	"ВО":      true,
+	"ВОТ":     true,
+	"ВПРОЧЕМ": true,
+	"ВСЕ":     true,
+	"ВСЕГДА":  true,
+	"ВСЕГО":   true,
+	"ВСЕХ":    true,
+	"ВСЮ":     true,
+	"ВЫ":      true,
+	"ГДЕ":     true,
+	"ДА":      true,
+	"ДАЖЕ":    true,
+	"ДВА":     true,
+	"ДЛЯ":     true,
+	"ДО":      true,
+	"ДРУГОЙ":  true,
+	"ЕГО":     true,
+	"ЕЕ":      true,
+	"ЕЙ":      true,
+	"ЕМУ":     true,
+	"ЕСЛИ":    true,
+	"ЕСТЬ":    true,
+	"ЕЩЕ":     true,
+	"Ж":       true,
+	"ЖЕ":      true,
+	"ЗА":      true,
+	"ЗАЧЕМ":   true,
+	"ЗДЕСЬ":   true,
+	"И":       true,
+	"ИЗ":      true,
+	"ИЛИ":     true,
+	"ИМ":      true,
+	"ИНОГДА":  true,
+	"ИХ":      true,
+	"К":       true,
+	"КАК":     true,
+	"КАКАЯ":   true,
+	"КАКОЙ":   true,
+	"КОГДА":   true,
+	"КОНЕЧНО": true,
+	"КТО":     true,
+	"КУДА":    true,
+	"ЛИ":      true,
+	"ЛУЧШЕ":   true,
+	"МЕЖДУ":   true,
+	"МЕНЯ":    true,
+	"МНЕ":     true,
+	"МНОГО":   true,
+	"МОЖЕТ":   true,
+	"МОЖНО":   true,
+	"МОЙ":     true,
+	"МОЯ":     true,
+	"МЫ":      true,
+	"НА":      true,
+	"НАД":     true,
+	"НАДО":    true,
+	"НАКОНЕЦ": true,
+	"НАС":     true,
+	"НЕ":      true,
+	"НЕГО":    true,
+	"НЕЕ":     true,
+	"НЕЙ":     true,
+	"НЕЛЬЗЯ":  true,
+	"НЕТ":     true,
+	"НИ":      true,
+	"НИБУДЬ":  true,
+	"НИКОГДА": true,
+	"НИМ":     true,
+	"НИХ":     true,
+	"НИЧЕГО":  true,
+	"НО":      true,
+	"НУ":      true,
+	"О":       true,
+	"ОБ":      true,
+	"ОДИН":    true,
+	"ОН":      true,
+	"ОНА":     true,
+	"ОНИ":     true,
+	"ОПЯТЬ":   true,
+	"ОТ":      true,
+	"ПЕРЕД":   true,
+	"ПО":      true,
+	"ПОД":     true,
+	"ПОСЛЕ":   true,
+	"ПОТОМ":   true,
+	"ПОТОМУ":  true,
+	"ПОЧТИ":   true,
+	"ПРИ":     true,
+	"ПРО":     true,
+	"РАЗ":     true,
+	"РАЗВЕ":   true,
+	"С":       true,
+	"САМ":     true,
+	"СВОЮ":    true,
+	"СЕБЕ":    true,
+	"СЕБЯ":    true,
+	"СЕЙЧАС":  true,
+	"СО":      true,
+	"СОВСЕМ":  true,
+	"ТАК":     true,
+	"ТАКОЙ":   true,
+	"ТАМ":     true,
+	"ТЕБЯ":    true,
+	"ТЕМ":     true,
+	"ТЕПЕРЬ":  true,
+	"ТО":      true,
+	"ТОГДА":   true,
+	"ТОГО":    true,
+	"ТОЖЕ":    true,
+	"ТОЛЬКО":  true,
+	"ТОМ":     true,
+	"ТОТ":     true,
+	"ТРИ":     true,
+	"ТУТ":     true,
+	"ТЫ":      true,
+	"У":       true,
+	"УЖ":      true,
+	"УЖЕ":     true,
+	"ХОРОШО":  true,
+	"ХОТЬ":    true,
+	"ЧЕГО":    true,
+	"ЧЕМ":     true,
+	"ЧЕРЕЗ":   true,
+	"ЧТО":     true,
+	"ЧТОБ":    true,
+	"ЧТОБЫ":   true,
+	"ЧУТЬ":    true,
+	"ЭТИ":     true,
+	"ЭТОГО":   true,
+	"ЭТОЙ":    true,
+	"ЭТОМ":    true,
+	"ЭТОТ":    true,
+	"ЭТУ":     true,
+	"Я":       true,
+}
@@ -1,7 +1,7 @@
-package main
+package analyzer
 
 // Stopwords taken from: https://www.ranks.nl/stopwords (Long Stopword List)
-var STOPWORDS = map[string]bool{
+var EnglishStopwords = map[string]bool{
 	"A":             true,
 	"ABLE":          true,
 	"ABOUT":         true,
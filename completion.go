@@ -0,0 +1,149 @@
+package main
+
+import (
+	"gosen/tfIndex"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Number of completions returned for the partially typed word
+const maxCompletions = 8
+
+// Number of documents returned along with the completions
+const maxCompletedDocs = 5
+
+// Partially typed words of fewer runes are not completed, they match too many tokens to be useful
+const minCompletedPrefix = 2
+
+type completion struct {
+	// Word completing the partially typed word, as written in the documents and normalized by the analyzer
+	Word string `json:"word"`
+	// Number of the documents containing the word, in the field the word is scoped to
+	DocFrequency uint `json:"docFrequency"`
+	// Query with its last word replaced by the completion
+	Query string `json:"query"`
+}
+
+type completedDoc struct {
+	DocID string `json:"docId"`
+	Title string `json:"title"`
+}
+
+// Splits the query into the text before the partially typed last word, the field the word is scoped to (empty when
+// it is not scoped) and the word itself. The word is empty when the query ends with a space or an operator, or when
+// the last word has runes other than letters and digits (e.g. it is a wildcard pattern)
+func lastPartialWord(queryString string) (string, string, string) {
+	start := strings.LastIndexFunc(queryString, unicode.IsSpace) + 1
+	skipOperators := func() {
+		for start < len(queryString) {
+			r, size := utf8.DecodeRuneInString(queryString[start:])
+			if unicode.IsLetter(r) || unicode.IsNumber(r) {
+				return
+			}
+			start += size
+		}
+	}
+	skipOperators()
+	field := ""
+	if name, _, ok := strings.Cut(queryString[start:], ":"); ok && slices.Contains(allFields, name) {
+		field = name
+		start += len(name) + 1
+		skipOperators()
+	}
+	word := queryString[start:]
+	if strings.IndexFunc(word, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsNumber(r) }) >= 0 {
+		return queryString, "", ""
+	}
+	return queryString[:start], field, word
+}
+
+// Returns the completions of the partially typed last word of the query, i.e. the surface words of the documents
+// starting with the normalized word, ranked by their document frequencies then alphabetically. The words scoped to a field are only
+// completed by the words indexed in the field, along with their document frequencies in the field. The keywords
+// (ext:md, lang:fr) and the ngrams are completed by the tokens of their fields, while the tokens of the other fields
+// are stems, which are never returned
+func completeQuery(index tfIndex.TFIndex, queryString string) ([]completion, error) {
+	before, field, word := lastPartialWord(queryString)
+	ret := []completion{}
+	if utf8.RuneCountInString(word) < minCompletedPrefix {
+		return ret, nil
+	}
+	keywords := field == extField || field == langField || field == ngramField
+	fieldPrefix := ""
+	var vocabulary map[string]uint
+	var err error
+	if keywords {
+		fieldPrefix = fieldToken(field, "")
+		vocabulary, err = index.Vocabulary(fieldPrefix + textAnalyzer.Normalize(word))
+	} else {
+		vocabulary, err = index.Words(textAnalyzer.Normalize(word))
+	}
+	if err != nil {
+		return nil, err
+	}
+	language := textAnalyzer.DetectLanguage(queryString)
+	for token, docFrequency := range vocabulary {
+		completed := strings.TrimPrefix(token, fieldPrefix)
+		if field != "" && !keywords {
+			if docFrequency, err = fieldDocFrequency(index, field, completed, language); err != nil {
+				return nil, err
+			}
+			if docFrequency == 0 {
+				continue
+			}
+		}
+		ret = append(ret, completion{Word: completed, DocFrequency: docFrequency, Query: before + completed})
+	}
+	slices.SortFunc(ret, func(a completion, b completion) int {
+		if a.DocFrequency != b.DocFrequency {
+			return int(b.DocFrequency) - int(a.DocFrequency)
+		}
+		return strings.Compare(a.Word, b.Word)
+	})
+	return ret[:min(len(ret), maxCompletions)], nil
+}
+
+// Returns the number of the documents containing the word analyzed in the language in the field
+func fieldDocFrequency(index tfIndex.TFIndex, field string, word string, language string) (uint, error) {
+	tokens, _ := fieldWords(field, word, language)
+	if len(tokens) != 1 {
+		return 0, nil
+	}
+	return index.DocFrequency(tokens[0])
+}
+
+// Returns the top documents of the query, completed by its first completion when there is one, along with their titles
+// recorded when they were indexed, or their file names when they have no title. The queries still being typed may not
+// parse (e.g. an unclosed phrase), they have no documents
+func completedDocs(index tfIndex.TFIndex, queryString string, completions []completion, scorer tfIndex.Scorer) ([]completedDoc, error) {
+	if len(completions) > 0 {
+		queryString = completions[0].Query
+	}
+	ret := []completedDoc{}
+	if strings.TrimSpace(queryString) == "" {
+		return ret, nil
+	}
+	searchQuery, _, err := mkSearchQuery(queryString)
+	if err != nil {
+		return ret, nil
+	}
+	results, err := index.QueryTopN(searchQuery, maxCompletedDocs, scorer, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		meta, _, err := index.Document(result.DocID)
+		if err != nil {
+			return nil, err
+		}
+		title := meta.Title
+		if title == "" {
+			title = filepath.Base(result.DocID)
+		}
+		ret = append(ret, completedDoc{DocID: result.DocID, Title: title})
+	}
+	return ret, nil
+}
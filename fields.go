@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"gosen/fileContents"
+	"gosen/tfIndex"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	bodyField  = "body"
+	titleField = "title"
+	nameField  = "name"
+	pathField  = "path"
+	extField   = "ext"
+	langField  = "lang"
+	// Ngrams of the words of the body, see analyzer.Token
+	ngramField = "ngram"
+)
+
+// Fields of a document, every field except the body is indexed with its tokens prefixed by `<field>:`. The ngram field
+// holds the ngrams of the words of the body
+var allFields = []string{bodyField, titleField, nameField, pathField, extField, langField, ngramField}
+
+// Fields searched by the words and phrases which are not scoped to a field
+var defaultFields = []string{bodyField, titleField, nameField, pathField}
+
+// Multipliers of the scores of the matches in each field
+type fieldBoostsValue map[string]float64
+
+var fieldBoosts = fieldBoostsValue{bodyField: 1.0, titleField: 3.0, nameField: 2.0, pathField: 1.0, extField: 1.0, langField: 1.0, ngramField: 0.5}
+
+func (boosts fieldBoostsValue) String() string {
+	parts := []string{}
+	for field, boost := range boosts {
+		parts = append(parts, fmt.Sprintf("%s=%g", field, boost))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+// Parses comma separated `<field>=<boost>` pairs, the fields which are not mentioned keep their boosts
+func (boosts fieldBoostsValue) Set(value string) error {
+	for _, pair := range strings.Split(value, ",") {
+		field, boostString, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return fmt.Errorf("expected <field>=<boost>, got `%s`", pair)
+		}
+		if _, ok := boosts[field]; !ok {
+			return fmt.Errorf("unknown field `%s`, supported fields: %v", field, allFields)
+		}
+		boost, err := strconv.ParseFloat(boostString, 64)
+		if err != nil || boost <= 0.0 {
+			return fmt.Errorf("boost of the field `%s` must be a positive number, got `%s`", field, boostString)
+		}
+		boosts[field] = boost
+	}
+	return nil
+}
+
+// Returns the token of the field, the tokens of the body are kept unprefixed
+func fieldToken(field string, token string) string {
+	if field == bodyField {
+		return token
+	}
+	return field + ":" + token
+}
+
+// Returns the tokens of the words of the text of the field analyzed in the language, along with their positions. The
+// extension and the language are kept whole
+func fieldWords(field string, text string, language string) ([]string, []uint) {
+	var tokens []string
+	var positions []uint
+	switch field {
+	case extField, langField:
+		if keyword := strings.ToLower(strings.TrimPrefix(text, ".")); keyword != "" {
+			tokens, positions = []string{keyword}, []uint{0}
+		}
+	default:
+		tokens, positions = words(text, language)
+	}
+	for i, token := range tokens {
+		tokens[i] = fieldToken(field, token)
+	}
+	return tokens, positions
+}
+
+// Returns the words of the text analyzed in the language as written, only normalized by the analyzer (e.g. lowercased).
+// They are not searched, they complete the partially typed words of the queries, see completeQuery
+func surfaceWords(text string, language string) []string {
+	var ret []string
+	for _, word := range textAnalyzer.WordsIn(text, language) {
+		ret = append(ret, textAnalyzer.Normalize(text[word.Start:word.End]))
+	}
+	return ret
+}
+
+// Extensions of the source files, whose identifiers are split into their sub-words
+var sourceExtensions = map[string]bool{
+	".go": true, ".ts": true, ".tsx": true, ".js": true, ".jsx": true, ".mjs": true, ".py": true, ".rb": true,
+	".java": true, ".kt": true, ".scala": true, ".cs": true, ".c": true, ".h": true, ".cc": true, ".cpp": true,
+	".hpp": true, ".rs": true, ".swift": true, ".php": true, ".lua": true, ".sh": true, ".sql": true, ".proto": true,
+}
+
+// Checks if the file is a source file by its extension
+func isSourceFile(filePath string) bool {
+	return sourceExtensions[strings.ToLower(filepath.Ext(filePath))]
+}
+
+// Returns the texts of the fields of the document, except its body
+func docFields(filePath string, title string, language string) map[string]string {
+	base := filepath.Base(filePath)
+	ext := filepath.Ext(base)
+	return map[string]string{
+		titleField: title,
+		nameField:  strings.TrimSuffix(base, ext),
+		pathField:  filepath.Dir(filePath) + string(filepath.Separator),
+		extField:   ext,
+		langField:  language,
+	}
+}
+
+// Returns the tokens of the document, i.e. the tokens of its body followed by the words of the other fields, along with
+// its metadata completed by its title and its language which all its fields are analyzed in. The length of the
+// document is the number of the words of its body, its words are the distinct surface words of the fields searched by
+// default, see surfaceWords
+func mkDocTokens(fileContent fileContents.FileContent, meta tfIndex.DocMeta) tfIndex.DocTokens {
+	meta.Title = fileContent.Title
+	meta.Language = textAnalyzer.DetectLanguage(fileContent.Content)
+	tokens, positions, words := tokenizeWithPositions(fileContent.Content, meta.Language, isSourceFile(fileContent.FilePath))
+	length := uint(len(words))
+	fields := docFields(fileContent.FilePath, fileContent.Title, meta.Language)
+	for _, field := range allFields {
+		if field == bodyField {
+			continue
+		}
+		fieldTokens, fieldPositions := fieldWords(field, fields[field], meta.Language)
+		tokens = append(tokens, fieldTokens...)
+		positions = append(positions, fieldPositions...)
+		if slices.Contains(defaultFields, field) {
+			words = append(words, surfaceWords(fields[field], meta.Language)...)
+		}
+	}
+	slices.Sort(words)
+	words = slices.Compact(words)
+	return tfIndex.DocTokens{DocID: fileContent.FilePath, Tokens: tokens, Positions: positions, Length: length, Words: words, Meta: meta}
+}
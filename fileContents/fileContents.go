@@ -2,10 +2,13 @@ package fileContents
 
 import (
 	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/xml"
 	"fmt"
 	"gosen/saxlike"
 	"gosen/slog"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -39,6 +42,48 @@ func readXML(filePath string) (string, error) {
 	return handler.textDataSB.String(), nil
 }
 
+type titleHandler struct {
+	saxlike.VoidHandler
+	inTitle bool
+	found   bool
+	titleSB strings.Builder
+}
+
+func (h *titleHandler) StartElement(e xml.StartElement) {
+	if !h.found && strings.EqualFold(e.Name.Local, "title") {
+		h.inTitle = true
+	}
+}
+
+func (h *titleHandler) EndElement(e xml.EndElement) {
+	if h.inTitle && strings.EqualFold(e.Name.Local, "title") {
+		h.inTitle = false
+		h.found = true
+	}
+}
+
+func (h *titleHandler) CharData(c xml.CharData) {
+	if h.inTitle {
+		h.titleSB.Write(c)
+	}
+}
+
+// Returns the text of the first <title> element of the file
+func readXMLTitle(filePath string) (string, error) {
+	fp, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("readXMLTitle: failed reading the filePath %s: %w", filePath, err)
+	}
+	defer fp.Close()
+	handler := &titleHandler{}
+	parser := saxlike.NewParser(bufio.NewReader(fp), handler)
+	err = parser.Parse()
+	if err != nil && !handler.found {
+		return "", fmt.Errorf("readXMLTitle: failed parsing the file %s using saxlike: %w", filePath, err)
+	}
+	return strings.TrimSpace(handler.titleSB.String()), nil
+}
+
 func readPDF(path string) (string, bool) {
 	defer func() {
 		if err := recover(); err != nil {
@@ -72,6 +117,50 @@ func readPDF(path string) (string, bool) {
 	return sb.String(), true
 }
 
+// Returns the title recorded in the document information dictionary of the pdf
+func readPDFTitle(path string) (title string) {
+	defer func() {
+		if err := recover(); err != nil {
+			slog.Errorf("panic occurred: %s", err)
+			title = ""
+		}
+	}()
+	f, r, err := pdf.Open(path)
+	if err != nil {
+		slog.Errorf("readPDFTitle: failed to open the file `%s`: %s!; returning with empty string", path, err)
+		return ""
+	}
+	defer f.Close()
+	return strings.TrimSpace(r.Trailer().Key("Info").Key("Title").Text())
+}
+
+// Returns the text of the first ATX heading (`# Title`) of the markdown content, i.e. 1 to 6 `#` followed by a space. The
+// lines of the fenced code blocks (``` or ~~~) are skipped, so that their comments (#!/bin/sh, #include) are never
+// taken for headings
+func markdownTitle(content string) string {
+	fence := ""
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(line, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "```") || strings.HasPrefix(line, "~~~") {
+			fence = line[:3]
+			continue
+		}
+		level := len(line) - len(strings.TrimLeft(line, "#"))
+		if level >= 1 && level <= 6 && (len(line) == level || line[level] == ' ' || line[level] == '\t') {
+			if title := strings.TrimSpace(strings.TrimRight(line[level:], "#")); title != "" {
+				return title
+			}
+		}
+	}
+	return ""
+}
+
 func readText(filePath string) (string, error) {
 	bytes, err := os.ReadFile(filePath)
 	if err != nil {
@@ -98,6 +187,27 @@ func FromFilePath(filePath string) (string, error) {
 	}
 }
 
+// Extracts the title of the file: the <title> of html/xml files, the Title metadata of pdfs or the first heading of
+// markdown files. Returns an empty string when the file has no title
+func Title(filePath string, content string) string {
+	parts := strings.Split(filePath, ".")
+	ext := parts[len(parts)-1]
+	switch ext {
+	case "xhtml", "html", "xml", "svg":
+		title, err := readXMLTitle(filePath)
+		if err != nil {
+			slog.Errorf("Title: %s", err)
+		}
+		return title
+	case "pdf":
+		return readPDFTitle(filePath)
+	case "md", "markdown":
+		return markdownTitle(content)
+	default:
+	}
+	return ""
+}
+
 func listFiles(directory string) ([]string, error) {
 	var files []string
 
@@ -116,26 +226,66 @@ func listFiles(directory string) ([]string, error) {
 
 type FileContent struct {
 	FilePath string
+	Title    string
 	Content  string
 	Err      error
 }
 
-func FromDirectory(dirPath string, bufferSize uint) (<-chan FileContent, error) {
+// Regular file along with its size and modification time (in unix nanoseconds)
+type FileStat struct {
+	FilePath string
+	Size     int64
+	ModTime  int64
+}
+
+// Lists all the regular files present in the directory, along with their absolute paths, sizes and modification times
+func StatDirectory(dirPath string) ([]FileStat, error) {
 	files, err := listFiles(dirPath)
 	if err != nil {
-		return nil, fmt.Errorf("FromDirectory: failed reading files from the directory %s: %w", dirPath, err)
+		return nil, fmt.Errorf("StatDirectory: failed reading files from the directory %s: %w", dirPath, err)
+	}
+	var ret []FileStat
+	for _, filePath := range files {
+		filePath, _ := filepath.Abs(filePath)
+		fi, err := os.Stat(filePath)
+		if err != nil || !fi.Mode().IsRegular() {
+			continue
+		}
+		ret = append(ret, FileStat{FilePath: filePath, Size: fi.Size(), ModTime: fi.ModTime().UnixNano()})
+	}
+	return ret, nil
+}
+
+// Returns the hex encoded sha256 hash of the content of the file
+func Hash(filePath string) (string, error) {
+	fp, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("Hash: failed reading the filePath %s: %w", filePath, err)
 	}
+	defer fp.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, fp); err != nil {
+		return "", fmt.Errorf("Hash: failed hashing the filePath %s: %w", filePath, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func FromFilePaths(filePaths []string, bufferSize uint) <-chan FileContent {
 	fileContentsCh := make(chan FileContent, bufferSize)
 	go func() {
 		defer close(fileContentsCh)
-		for _, filePath := range files {
+		for _, filePath := range filePaths {
 			filePath, _ := filepath.Abs(filePath)
-			if fi, _ := os.Stat(filePath); fi.Mode().IsRegular() {
+			if fi, err := os.Stat(filePath); err == nil && fi.Mode().IsRegular() {
 				slog.Infof("Reading file `%s`...", filePath)
 				fileContent, err := FromFilePath(filePath)
-				fileContentsCh <- FileContent{FilePath: filePath, Content: fileContent, Err: err}
+				title := ""
+				if err == nil {
+					title = Title(filePath, fileContent)
+				}
+				fileContentsCh <- FileContent{FilePath: filePath, Title: title, Content: fileContent, Err: err}
 			}
 		}
 	}()
-	return fileContentsCh, nil
+	return fileContentsCh
 }
@@ -12,43 +12,203 @@ function mkHeader(header) {
     return item;
 }
 
+/** Creates snippet node given a snippet
+ * 
+ * @param {string} snippet - html escaped snippet, with the matched words marked by <mark> spans
+ * @returns HTMLElement - snippet as a small element
+ */
+function mkSnippet(snippet) {
+    const item = document.createElement("small");
+    item.innerHTML = snippet;
+    const container = document.createElement("span");
+    container.appendChild(item);
+    container.appendChild(document.createElement("br"));
+    return container;
+}
+
+/** Creates the node suggesting the corrected query, searching for it when clicked
+ * 
+ * @param {string} suggestion - corrected query
+ * @param {integer} topN - top n results to show
+ * @returns HTMLSpanElement - suggestion as a span element
+ */
+function mkSuggestion(suggestion, topN) {
+    const item = document.createElement("span");
+    item.appendChild(document.createTextNode("Did you mean: "));
+    const link = document.createElement("a");
+    link.href = "#";
+    link.appendChild(document.createTextNode(suggestion));
+    link.onclick = function (e) {
+        e.preventDefault();
+        const query = document.getElementById("query");
+        if (query !== null) {
+            query.value = suggestion;
+        }
+        search(suggestion, topN);
+    }
+    item.appendChild(link);
+    item.appendChild(document.createTextNode("?"));
+    item.appendChild(document.createElement("br"));
+    return item;
+}
+
+/** Milliseconds to wait after the last keystroke before fetching the suggestions */
+const suggestDebounce = 200;
+
+/** Creates the node of a clickable suggestion, calling onclick when clicked
+ * 
+ * @param {string} text - text of the suggestion
+ * @param {string} detail - detail shown next to the text in small
+ * @param {function} onclick - called when the suggestion is clicked
+ * @returns HTMLSpanElement - suggestion as a span element
+ */
+function mkSuggestItem(text, detail, onclick) {
+    const item = document.createElement("span");
+    const link = document.createElement("a");
+    link.href = "#";
+    link.appendChild(document.createTextNode(text));
+    link.onclick = function (e) {
+        e.preventDefault();
+        onclick();
+    }
+    item.appendChild(link);
+    const small = document.createElement("small");
+    small.appendChild(document.createTextNode(` ${detail}`));
+    item.appendChild(small);
+    item.appendChild(document.createElement("br"));
+    return item;
+}
+
+/** Fetches the completions of the partially typed last word of the query and the top documents from /api/suggest,
+ * and shows them in the suggestions dropdown. Clicking a completion completes the query, clicking a document searches
+ * for the completed query
+ * 
+ * @param {string} prompt - query string being typed
+ * @param {function} isStale - tells if a newer prompt was typed since, whose suggestions replace these
+ * @param {function} onpick - called with the picked query
+ */
+async function suggest(prompt, isStale, onpick) {
+    const suggestions = document.getElementById("suggestions");
+    if (suggestions === null) {
+        return;
+    }
+    if (prompt.trim() === "") {
+        suggestions.innerHTML = "";
+        return;
+    }
+    const response = await fetch("/api/suggest?" + new URLSearchParams({ "q": prompt }));
+    if (!response.ok || isStale()) {
+        return;
+    }
+    /**
+     * @type {{completions: [{word: string, docFrequency: number, query: string}], docs: [{docId: string, title: string}]}}
+     */
+    const json = await response.json();
+    if (isStale()) {
+        return;
+    }
+    suggestions.innerHTML = "";
+    for (const { word, docFrequency, query } of json.completions) {
+        suggestions.appendChild(mkSuggestItem(word, `(${docFrequency} documents)`, () => onpick(query, false)));
+    }
+    const completed = json.completions.length > 0 ? json.completions[0].query : prompt;
+    for (const { docId, title } of json.docs) {
+        suggestions.appendChild(mkSuggestItem(title, docId, () => onpick(completed, true)));
+    }
+}
+
+/** Creates the node linking to the previous and the next pages of the results
+ * 
+ * @param {string} prompt - query string
+ * @param {integer} topN - number of results per page
+ * @param {integer} offset - offset of the shown page
+ * @param {integer | undefined} nextOffset - offset of the next page, undefined on the last page
+ * @returns HTMLSpanElement - pager as a span element
+ */
+function mkPager(prompt, topN, offset, nextOffset) {
+    const item = document.createElement("span");
+    const mkLink = function (text, pageOffset) {
+        const link = document.createElement("a");
+        link.href = "#";
+        link.appendChild(document.createTextNode(text));
+        link.onclick = function (e) {
+            e.preventDefault();
+            search(prompt, topN, pageOffset);
+        }
+        return link;
+    }
+    if (offset > 0) {
+        item.appendChild(mkLink("< previous", Math.max(0, offset - topN)));
+    }
+    if (offset > 0 && nextOffset !== undefined) {
+        item.appendChild(document.createTextNode(" | "));
+    }
+    if (nextOffset !== undefined) {
+        item.appendChild(mkLink("next >", nextOffset));
+    }
+    item.appendChild(document.createElement("br"));
+    return item;
+}
+
 /** searches for a given prompt to /api/search server, and correspondingly updates the ui with the results
  * 
  * @param {string} prompt - query string
  * @param {integer} topN - top n results to show
+ * @param {integer | undefined} offset - number of top results to skip, 0 when undefined
  */
-async function search(prompt, topN) {
+async function search(prompt, topN, offset) {
     if (topN === undefined || isNaN(topN) || topN <= 0) {
         topN = 1
     }
+    if (offset === undefined) {
+        offset = 0
+    }
     const query = {
         "search": prompt,
         "topN": topN,
+        "offset": offset,
     }
     const results = document.getElementById("results")
     if (results == null) {
         return
     }
     results.innerHTML = "";
+    const suggestions = document.getElementById("suggestions");
+    if (suggestions !== null) {
+        suggestions.innerHTML = "";
+    }
     const response = await fetch("/api/search", {
         method: 'POST',
         headers: { 'Content-Type': 'application/json' },
         body: JSON.stringify(query),
     });
+    if (!response.ok) {
+        results.appendChild(mkHeader(await response.text()));
+        return;
+    }
     /**
-     * @type {[{docId: string, score: number}]}
+     * @type {{hits: [{docId: string, score: number, snippets: [string] | undefined}], total: number, offset: number, topN: number, nextOffset: number | undefined, suggestion: string | undefined}}
      */
-    const jsonArr = await response.json();
+    const json = await response.json();
     results.innerHTML = "";
-    const headerNode = mkHeader(`Showing top ${Math.min(topN, jsonArr.length)} results:`);
-    results.appendChild(headerNode);
-    for (let i = 0; i < jsonArr.length; i++) {
-        const { docId, score } = jsonArr[i];
+    if (json.suggestion !== undefined) {
+        results.appendChild(mkSuggestion(json.suggestion, topN));
+    }
+    const total = json.total.toLocaleString();
+    const header = json.hits.length === 0 ?
+        `No results to show, ${total} results in total` :
+        `Showing results ${json.offset + 1} to ${json.offset + json.hits.length} of ${total}:`;
+    results.appendChild(mkHeader(header));
+    for (const { docId, snippets } of json.hits) {
         const item = document.createElement("span");
         item.appendChild(document.createTextNode(docId));
         item.appendChild(document.createElement("br"));
+        for (const snippet of snippets || []) {
+            item.appendChild(mkSnippet(snippet));
+        }
         results.appendChild(item);
     }
+    results.appendChild(mkPager(prompt, topN, json.offset, json.nextOffset));
 }
 
 /** Main setup
@@ -72,9 +232,27 @@ function setup() {
         return;
     }
     const currentSearch = Promise.resolve();
+    // counts the prompts typed, the suggestions of the older prompts are discarded
+    let typed = 0;
+    let timeout = undefined;
     searchButton.onclick = function (e) {
+        typed++;
         currentSearch.then(() => search(query.value, topN.value * 1));
     }
+    const onpick = function (picked, searchNow) {
+        typed++;
+        document.getElementById("suggestions").innerHTML = "";
+        query.value = picked;
+        query.focus();
+        if (searchNow) {
+            currentSearch.then(() => search(picked, topN.value * 1));
+        }
+    }
+    query.oninput = function (e) {
+        const current = ++typed;
+        clearTimeout(timeout);
+        timeout = setTimeout(() => suggest(query.value, () => current !== typed, onpick), suggestDebounce);
+    }
 }
 
 setup();
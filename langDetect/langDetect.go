@@ -0,0 +1,135 @@
+package langDetect
+
+import (
+	"gosen/tokenizer"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	maxNgramSize = 4
+	// Number of the leading words of a text considered for detecting its language
+	maxWords = 1000
+	// Number of the words a text needs for its language to be detected
+	minWords = 3
+	// Minimum difference between the average log probabilities of the ngrams of the text in the two most likely
+	// languages, for the most likely one to be trusted
+	minMargin = 0.05
+	// Code of the language of the texts whose words are mostly written in the scripts of none of the languages, e.g.
+	// Japanese
+	Unknown = "unknown"
+)
+
+// Scripts the languages are written in
+var scripts = []*unicode.RangeTable{unicode.Latin, unicode.Cyrillic}
+
+// Frequencies of the ngrams of a text
+type profile struct {
+	counts map[string]int
+	total  int
+}
+
+var profiles = map[string]profile{}
+
+// Number of the distinct ngrams of all the profiles, used for smoothing the probabilities of the unseen ngrams
+var vocabularySize int
+
+func init() {
+	vocabulary := map[string]bool{}
+	for language, sample := range samples {
+		profiles[language], _, _ = mkProfile(sample)
+		for ngram := range profiles[language].counts {
+			vocabulary[ngram] = true
+		}
+	}
+	vocabularySize = len(vocabulary)
+}
+
+// Returns the ngrams of the word padded by `_` on both sides, of sizes 1 to maxNgramSize
+func wordNgrams(word string) []string {
+	runes := []rune("_" + word + "_")
+	var ret []string
+	for n := 1; n <= maxNgramSize; n++ {
+		for i := 0; i+n <= len(runes); i++ {
+			ngram := string(runes[i : i+n])
+			if ngram != "_" {
+				ret = append(ret, ngram)
+			}
+		}
+	}
+	return ret
+}
+
+// Returns the profile of the words of the text skipping the numbers, along with the number of the words. The words
+// written in other scripts than the scripts of the languages are only counted as unknown words
+func mkProfile(text string) (ret profile, words int, unknownWords int) {
+	t := tokenizer.UnicodeTokenizerFromString(text)
+	ret = profile{counts: map[string]int{}}
+	for words+unknownWords < maxWords && t.Contains() {
+		word := strings.ToLower(t.NextToken())
+		r, _ := utf8.DecodeRuneInString(word)
+		if unicode.IsNumber(r) {
+			continue
+		}
+		if !unicode.IsOneOf(scripts, r) {
+			unknownWords++
+			continue
+		}
+		for _, ngram := range wordNgrams(word) {
+			ret.counts[ngram]++
+			ret.total++
+		}
+		words++
+	}
+	return ret, words, unknownWords
+}
+
+// Returns the average log probability of the ngrams of the profile in the language, with add-one smoothing
+func (p profile) logProbability(language profile) float64 {
+	sum := 0.0
+	for ngram, count := range p.counts {
+		probability := float64(language.counts[ngram]+1) / float64(language.total+vocabularySize)
+		sum += float64(count) * math.Log(probability)
+	}
+	return sum / float64(p.total)
+}
+
+// Returns the ISO 639-1 codes of the languages which can be detected
+func Languages() []string {
+	ret := []string{}
+	for language := range profiles {
+		ret = append(ret, language)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Returns the ISO 639-1 code of the most likely language of the text, Unknown when most of its words are written in
+// other scripts than the scripts of the languages. ok is false when the text is too short or its language is not clear
+// enough
+func Detect(text string) (language string, ok bool) {
+	p, words, unknownWords := mkProfile(text)
+	if unknownWords > words {
+		return Unknown, true
+	}
+	if words < minWords {
+		return "", false
+	}
+	best, second := "", ""
+	scores := map[string]float64{}
+	for _, candidate := range Languages() {
+		scores[candidate] = p.logProbability(profiles[candidate])
+		if best == "" || scores[candidate] > scores[best] {
+			best, second = candidate, best
+		} else if second == "" || scores[candidate] > scores[second] {
+			second = candidate
+		}
+	}
+	if scores[best]-scores[second] < minMargin {
+		return "", false
+	}
+	return best, true
+}
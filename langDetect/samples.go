@@ -0,0 +1,138 @@
+package langDetect
+
+// Sample texts of the languages, their ngrams make up the profiles of the languages
+var samples = map[string]string{
+	"en": `The system keeps an index of all the documents in the folder, so that the user can find the files which are
+most relevant to the words of the query. When a document is added or changed, it is read again and the words that
+it contains are counted. Each of the words is given a weight depending on how often it appears in the document and
+how rare it is across the whole collection. The results are then sorted by their score, and the best of them are
+shown first. This is a simple and well known method, which has been used for many years by search engines and
+libraries. It works quite well for most of the texts that people write every day, such as notes, reports, letters
+and books. There are however some things that should be taken into account, for example the language of the text,
+because the rules for finding the root of a word are different in every language. We would like to thank everyone
+who helped with this work, and we hope that you will find it useful.
+The server opens a connection to the database when it starts, and keeps a pool of connections ready for the
+requests. If the database does not answer within the timeout, the request fails with an error and the connection is
+closed. You can change these settings in the configuration file, which is read again whenever it is modified. Please
+make sure that the user running the service has the permission to write to the log directory, otherwise the errors
+will not be recorded. After the upgrade, run the migration script and check that all the tables have been created.
+We recommend keeping a backup of your data before making any changes to the system.`,
+	"fr": `Le système conserve un index de tous les documents du dossier, afin que l'utilisateur puisse trouver les
+fichiers qui sont les plus pertinents pour les mots de la requête. Lorsqu'un document est ajouté ou modifié, il est
+lu de nouveau et les mots qu'il contient sont comptés. Chacun des mots reçoit un poids qui dépend de la fréquence à
+laquelle il apparaît dans le document et de sa rareté dans l'ensemble de la collection. Les résultats sont ensuite
+triés selon leur score, et les meilleurs sont affichés en premier. C'est une méthode simple et bien connue, qui est
+utilisée depuis de nombreuses années par les moteurs de recherche et les bibliothèques. Elle fonctionne assez bien
+pour la plupart des textes que les gens écrivent chaque jour, comme des notes, des rapports, des lettres et des
+livres. Il faut cependant tenir compte de certaines choses, par exemple de la langue du texte, car les règles pour
+trouver la racine d'un mot sont différentes dans chaque langue. Nous voudrions remercier tous ceux qui ont aidé à ce
+travail, et nous espérons que vous le trouverez utile.
+Le serveur ouvre une connexion à la base de données lorsqu'il démarre, et garde un ensemble de connexions
+prêtes pour les requêtes. Si la base de données ne répond pas avant le délai d'attente, la requête échoue avec une
+erreur et la connexion est fermée. Vous pouvez modifier ces paramètres dans le fichier de configuration, qui est relu
+chaque fois qu'il est modifié. Veuillez vous assurer que l'utilisateur qui exécute le service a le droit d'écrire dans
+le répertoire des journaux, sinon les erreurs ne seront pas enregistrées. Après la mise à jour, lancez le script de
+migration et vérifiez que toutes les tables ont été créées. Nous vous conseillons de garder une sauvegarde de vos
+données avant d'apporter des changements au système.`,
+	"de": `Das System führt einen Index aller Dokumente im Ordner, damit der Benutzer die Dateien finden kann, die für
+die Wörter der Anfrage am wichtigsten sind. Wenn ein Dokument hinzugefügt oder geändert wird, wird es erneut gelesen
+und die Wörter, die es enthält, werden gezählt. Jedes Wort erhält ein Gewicht, das davon abhängt, wie oft es in dem
+Dokument vorkommt und wie selten es in der gesamten Sammlung ist. Die Ergebnisse werden dann nach ihrer Bewertung
+sortiert, und die besten werden zuerst angezeigt. Dies ist eine einfache und bekannte Methode, die seit vielen Jahren
+von Suchmaschinen und Bibliotheken verwendet wird. Sie funktioniert recht gut für die meisten Texte, die Menschen
+jeden Tag schreiben, wie Notizen, Berichte, Briefe und Bücher. Es gibt jedoch einige Dinge, die berücksichtigt werden
+sollten, zum Beispiel die Sprache des Textes, weil die Regeln für das Finden der Wurzel eines Wortes in jeder Sprache
+anders sind. Wir möchten allen danken, die bei dieser Arbeit geholfen haben, und wir hoffen, dass Sie sie nützlich
+finden werden.
+Der Server öffnet beim Start eine Verbindung zur Datenbank und hält einen Vorrat an Verbindungen für die
+Anfragen bereit. Wenn die Datenbank nicht innerhalb der Zeitüberschreitung antwortet, schlägt die Anfrage mit einem
+Fehler fehl und die Verbindung wird geschlossen. Sie können diese Einstellungen in der Konfigurationsdatei ändern,
+die jedes Mal neu gelesen wird, wenn sie geändert wird. Bitte stellen Sie sicher, dass der Benutzer, der den Dienst
+ausführt, die Berechtigung hat, in das Protokollverzeichnis zu schreiben, sonst werden die Fehler nicht
+aufgezeichnet. Führen Sie nach der Aktualisierung das Migrationsskript aus und prüfen Sie, ob alle Tabellen erstellt
+wurden. Wir empfehlen, vor jeder Änderung am System eine Sicherung Ihrer Daten anzulegen.`,
+	"es": `El sistema mantiene un índice de todos los documentos de la carpeta, para que el usuario pueda encontrar los
+archivos que son más relevantes para las palabras de la consulta. Cuando se añade o se cambia un documento, se vuelve
+a leer y se cuentan las palabras que contiene. A cada una de las palabras se le da un peso que depende de la
+frecuencia con la que aparece en el documento y de lo rara que es en toda la colección. Los resultados se ordenan
+luego según su puntuación, y los mejores se muestran primero. Es un método sencillo y muy conocido, que ha sido
+utilizado durante muchos años por los motores de búsqueda y las bibliotecas. Funciona bastante bien para la mayoría
+de los textos que las personas escriben cada día, como notas, informes, cartas y libros. Sin embargo, hay algunas
+cosas que se deben tener en cuenta, por ejemplo el idioma del texto, porque las reglas para encontrar la raíz de una
+palabra son diferentes en cada idioma. Queremos dar las gracias a todos los que ayudaron con este trabajo, y
+esperamos que les resulte útil.
+El servidor abre una conexión con la base de datos cuando se inicia, y mantiene un conjunto de conexiones
+listas para las peticiones. Si la base de datos no responde dentro del tiempo de espera, la petición falla con un
+error y la conexión se cierra. Puede cambiar estos ajustes en el archivo de configuración, que se vuelve a leer cada
+vez que se modifica. Asegúrese de que el usuario que ejecuta el servicio tenga permiso para escribir en el directorio
+de registros, de lo contrario los errores no se guardarán. Después de la actualización, ejecute el programa de
+migración y compruebe que se han creado todas las tablas. Le recomendamos que haga una copia de seguridad de sus
+datos antes de realizar cualquier cambio en el sistema.`,
+	"it": `Il sistema mantiene un indice di tutti i documenti della cartella, in modo che l'utente possa trovare i file
+che sono più rilevanti per le parole della ricerca. Quando un documento viene aggiunto o modificato, viene letto di
+nuovo e le parole che contiene vengono contate. A ciascuna delle parole viene dato un peso che dipende da quanto
+spesso compare nel documento e da quanto è rara nell'intera collezione. I risultati vengono poi ordinati secondo il
+loro punteggio, e i migliori vengono mostrati per primi. Questo è un metodo semplice e ben conosciuto, che è stato
+usato per molti anni dai motori di ricerca e dalle biblioteche. Funziona abbastanza bene per la maggior parte dei
+testi che le persone scrivono ogni giorno, come appunti, rapporti, lettere e libri. Ci sono però alcune cose di cui
+si dovrebbe tenere conto, per esempio la lingua del testo, perché le regole per trovare la radice di una parola sono
+diverse in ogni lingua. Vorremmo ringraziare tutti quelli che hanno aiutato in questo lavoro, e speriamo che lo
+troverete utile.
+Il server apre una connessione al database quando si avvia, e tiene pronto un insieme di connessioni per le
+richieste. Se il database non risponde entro il tempo massimo, la richiesta fallisce con un errore e la connessione
+viene chiusa. Potete cambiare queste impostazioni nel file di configurazione, che viene riletto ogni volta che viene
+modificato. Assicuratevi che l'utente che esegue il servizio abbia il permesso di scrivere nella cartella dei
+registri, altrimenti gli errori non saranno salvati. Dopo l'aggiornamento, eseguite lo script di migrazione e
+controllate che tutte le tabelle siano state create. Vi consigliamo di fare una copia dei vostri dati prima di
+apportare qualsiasi modifica al sistema. Gli studenti della scuola hanno letto molti libri quest'anno, e ognuno di
+loro ha scritto una breve storia sulla propria famiglia e sulla città in cui vive.`,
+	"pt": `O sistema mantém um índice de todos os documentos da pasta, para que o usuário possa encontrar os arquivos
+que são mais relevantes para as palavras da consulta. Quando um documento é adicionado ou alterado, ele é lido
+novamente e as palavras que ele contém são contadas. Cada uma das palavras recebe um peso que depende de quantas vezes
+ela aparece no documento e de quão rara ela é em toda a coleção. Os resultados são então ordenados pela sua
+pontuação, e os melhores são mostrados primeiro. Este é um método simples e bem conhecido, que tem sido usado há
+muitos anos pelos motores de busca e pelas bibliotecas. Ele funciona muito bem para a maioria dos textos que as
+pessoas escrevem todos os dias, como notas, relatórios, cartas e livros. No entanto, há algumas coisas que devem ser
+levadas em conta, por exemplo a língua do texto, porque as regras para encontrar a raiz de uma palavra são diferentes
+em cada língua. Gostaríamos de agradecer a todos que ajudaram neste trabalho, e esperamos que você o ache útil.
+O servidor abre uma conexão com o banco de dados quando é iniciado, e mantém um conjunto de conexões prontas
+para as requisições. Se o banco de dados não responder dentro do tempo limite, a requisição falha com um erro e a
+conexão é fechada. Você pode alterar essas configurações no arquivo de configuração, que é lido novamente sempre que
+é modificado. Certifique-se de que o usuário que executa o serviço tem permissão para escrever no diretório de
+registros, caso contrário os erros não serão gravados. Depois da atualização, execute o script de migração e
+verifique se todas as tabelas foram criadas. Recomendamos fazer uma cópia de segurança dos seus dados antes de
+fazer qualquer mudança no sistema. Os alunos da escola leram muitos livros este ano, e cada um deles escreveu uma
+pequena história sobre a sua família e sobre a cidade onde vive. Não há nada melhor do que uma tarde de chuva em
+casa, com um café quente e um bom livro nas mãos.`,
+	"nl": `Het systeem houdt een index bij van alle documenten in de map, zodat de gebruiker de bestanden kan vinden die
+het meest relevant zijn voor de woorden van de zoekopdracht. Wanneer een document wordt toegevoegd of gewijzigd, wordt
+het opnieuw gelezen en worden de woorden die het bevat geteld. Elk van de woorden krijgt een gewicht dat afhangt van
+hoe vaak het in het document voorkomt en hoe zeldzaam het is in de hele verzameling. De resultaten worden daarna
+gesorteerd op hun score, en de beste worden eerst getoond. Dit is een eenvoudige en bekende methode, die al vele jaren
+door zoekmachines en bibliotheken wordt gebruikt. Het werkt vrij goed voor de meeste teksten die mensen elke dag
+schrijven, zoals notities, verslagen, brieven en boeken. Er zijn echter enkele dingen waar rekening mee moet worden
+gehouden, bijvoorbeeld de taal van de tekst, omdat de regels voor het vinden van de stam van een woord in elke taal
+anders zijn. We willen iedereen bedanken die bij dit werk heeft geholpen, en we hopen dat u het nuttig zult vinden.
+De server opent een verbinding met de database wanneer hij start, en houdt een aantal verbindingen klaar voor
+de verzoeken. Als de database niet binnen de wachttijd antwoordt, mislukt het verzoek met een fout en wordt de
+verbinding gesloten. U kunt deze instellingen wijzigen in het configuratiebestand, dat opnieuw wordt gelezen telkens
+wanneer het wordt gewijzigd. Zorg ervoor dat de gebruiker die de dienst uitvoert het recht heeft om in de map met
+logbestanden te schrijven, anders worden de fouten niet vastgelegd. Voer na de upgrade het migratiescript uit en
+controleer of alle tabellen zijn aangemaakt. We raden aan om een reservekopie van uw gegevens te maken voordat u
+iets aan het systeem verandert.`,
+	"ru": `Система хранит индекс всех документов в папке, чтобы пользователь мог найти файлы, которые наиболее
+соответствуют словам запроса. Когда документ добавляется или изменяется, он читается заново, и слова, которые он
+содержит, подсчитываются. Каждому слову присваивается вес, который зависит от того, как часто оно встречается в
+документе и насколько оно редко во всей коллекции. Затем результаты сортируются по их оценке, и лучшие из них
+показываются первыми. Это простой и хорошо известный метод, который уже много лет используется поисковыми системами
+и библиотеками. Он довольно хорошо работает для большинства текстов, которые люди пишут каждый день, таких как
+заметки, отчёты, письма и книги. Однако есть некоторые вещи, которые следует учитывать, например язык текста, потому
+что правила нахождения основы слова в каждом языке разные. Мы хотели бы поблагодарить всех, кто помог в этой работе,
+и надеемся, что вы найдёте её полезной.
+Сервер открывает соединение с базой данных при запуске и держит набор соединений готовыми для запросов.
+Если база данных не отвечает в течение времени ожидания, запрос завершается с ошибкой, и соединение закрывается.
+Вы можете изменить эти настройки в файле конфигурации, который читается заново каждый раз, когда он изменяется.
+Убедитесь, что пользователь, от имени которого работает служба, имеет право записи в каталог журналов, иначе ошибки
+не будут сохранены. После обновления запустите скрипт миграции и проверьте, что все таблицы были созданы. Мы
+рекомендуем сделать резервную копию ваших данных перед любыми изменениями в системе.`,
+}
@@ -1,51 +1,125 @@
 package main
 
 import (
+	"crypto/subtle"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"gosen/analyzer"
 	"gosen/fileContents"
+	"gosen/queryParser"
 	"gosen/slog"
 	"gosen/stemmer"
-	"gosen/stemmer/snowball"
 	"gosen/tfIndex"
-	"gosen/tokenizer"
+	"io/fs"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 )
 
 const fileBufferSize uint = 100
 
+const defaultDebounce time.Duration = 500 * time.Millisecond
+
+// Default maximum number of tokens a pattern of the queries can match
+const defaultMaxExpansions uint = 1000
+
 const (
-	defaultDBPath   string = "index.db"
-	defaultAddr            = "127.0.0.1:6969"
-	buildSubCommand        = "build"
-	querySubCommand        = "query"
-	serveSubCommand        = "serve"
-	helpSubCommand         = "help"
+	defaultDBPath    string = "index.db"
+	defaultAddr             = "127.0.0.1:6969"
+	buildSubCommand         = "build"
+	querySubCommand         = "query"
+	serveSubCommand         = "serve"
+	removeSubCommand        = "remove"
+	watchSubCommand         = "watch"
+	helpSubCommand          = "help"
 )
 
 var (
-	dirPath     string
-	dbPath      string
-	queryString string
-	topN        uint
-	addr        string
+	dirPath      string
+	dbPath       string
+	queryString  string
+	topN         uint
+	offset       uint
+	addr         string
+	scorerName   string
+	bm25K1       float64
+	bm25B        float64
+	docPath      string
+	adminToken   string
+	watchDir     string
+	debounce     time.Duration
+	showSnippets bool
+	explain      bool
+	analyzerName string
+	lang         string
+	stopwords    string
+	accents      string
+	ngramSizes   string
+	synonymsPath string
+	synonymBoost float64
+	// Maximum number of tokens a pattern of the queries can match
+	maxExpansions uint
+)
+
+// Analyzer of the texts of the documents and the queries, the one the index is built with
+var textAnalyzer *analyzer.Analyzer
+
+const (
+	// Key of the metadata of the index storing the configuration of its analyzer
+	analyzerMetadataKey = "analyzer"
+	// Key of the metadata of the index storing the version of the format of its tokens
+	formatVersionMetadataKey = "formatVersion"
 )
 
+// Version of the format of the tokens of the index, bumped whenever the tokens of the same text change. The indexes
+// without a version are of version 1, whose words were never stemmed
+const indexFormatVersion = "9"
+
+func configSynonymsFlags(flg *flag.FlagSet) {
+	flg.StringVar(&synonymsPath, "synonyms", "", "Path of a thesaurus expanding the words and the phrases of the queries into their synonyms. Every line lists either equivalent words or phrases separated by commas: k8s, kubernetes or words expanding one way: lb => load balancer")
+	flg.Float64Var(&synonymBoost, "synonymBoost", defaultSynonymBoost, "Multiplier of the scores of the matches of the synonyms")
+}
+
+func configExpansionFlags(flg *flag.FlagSet) {
+	flg.UintVar(&maxExpansions, "maxExpansions", defaultMaxExpansions, "Maximum number of tokens a pattern of the query (auth*, co?fig) can match, the queries with broader patterns are refused")
+}
+
+func configScorerFlags(flg *flag.FlagSet) {
+	flg.StringVar(&scorerName, "scorer", tfIndex.TFIDFScorerName, fmt.Sprintf("Scoring model used for ranking. Supported scorers: [%s, %s]", tfIndex.TFIDFScorerName, tfIndex.BM25ScorerName))
+	flg.Float64Var(&bm25K1, "k1", tfIndex.DefaultBM25K1, "Term frequency saturation parameter of bm25")
+	flg.Float64Var(&bm25B, "b", tfIndex.DefaultBM25B, "Document length normalization parameter of bm25")
+	flg.Var(fieldBoosts, "fieldBoosts", fmt.Sprintf("Comma separated <field>=<boost> multipliers of the scores of the matches in each field. Supported fields: %v", allFields))
+}
+
 func configBuildFlagSet() *flag.FlagSet {
 	flg := flag.NewFlagSet(buildSubCommand, flag.ExitOnError)
 	flg.StringVar(&dirPath, "dir", "", "Directory containing the files")
 	flg.StringVar(&dbPath, "db", defaultDBPath, "Path of db to store the index. Supported formats: [.db, .json]")
+	flg.StringVar(&analyzerName, "analyzer", "", fmt.Sprintf("Analyzer splitting the texts into tokens, a new index is built with the `%s` analyzer when empty. Supported analyzers: %v", analyzer.DefaultConfigName, analyzer.ConfigNames()))
+	flg.StringVar(&lang, "lang", "", fmt.Sprintf("Code of the language the words are stemmed in, `%s` detects the language of each document. Defaults to the language of the index, a new index detects the language of each document. Supported languages: %v", analyzer.AutoLanguage, stemmer.Languages()))
+	flg.StringVar(&stopwords, "stopwords", "", fmt.Sprintf("Stopwords dropped from the documents and the queries: \"%s\" keeps all the words, a language code selects its built-in stopwords and any other value is the path of a file listing the stopwords, one per line. Defaults to the stopwords of the index or of the language for a new index", analyzer.NoStopwords))
+	flg.StringVar(&accents, "accents", "", fmt.Sprintf("Treatment of the diacritics (résumé, naïve): \"%s\" strips them, \"%s\" strips them and also indexes the words with them, ranking the queries with the same diacritics higher, \"%s\" keeps them. Defaults to the accents of the index or of the analyzer for a new index", analyzer.FoldAccents, analyzer.BothAccents, analyzer.KeepAccents))
+	flg.StringVar(&ngramSizes, "ngrams", "", fmt.Sprintf("Ngrams of the words indexed in the \"%s\" field: comma separated sizes (3,5,7), the sizes prefixed by \"edge:\" for the ngrams prefixing the words (edge:2,3,4) or \"%s\". Defaults to the ngrams of the index or of the analyzer for a new index", ngramField, analyzer.NoNgrams))
 	return flg
 }
 
 func configQueryFlagSet() *flag.FlagSet {
 	flg := flag.NewFlagSet(querySubCommand, flag.ExitOnError)
 	flg.StringVar(&dbPath, "db", defaultDBPath, "Path of db to store the index. Supported formats: [.db, .json]")
-	flg.StringVar(&queryString, "query", "", "Search query")
+	flg.StringVar(&queryString, "query", "", "Search query. Supports quoted phrases: \"connection pool\", proximity constraints: raft NEAR/3 timeout, fuzzy words within 1 or 2 edits: leadr~1 electon~2, patterns of words: auth* *config* co?fig, required and excluded terms: +kubernetes -helm, boolean operators with grouping: (postgres OR mysql) AND NOT replication, words or phrases scoped to a field: title:raft ext:pdf path:design/, and the language of the documents: +lang:fr")
 	flg.UintVar(&topN, "topN", 10, "Top N results to show")
+	flg.UintVar(&offset, "offset", 0, "Number of the top results to skip, for showing the next pages of topN results")
+	flg.BoolVar(&showSnippets, "snippets", true, "Show the snippets of the results, with the matched words marked by **")
+	flg.BoolVar(&explain, "explain", false, "Explain the scores of the results, showing the contribution of each token of the query")
+	flg.StringVar(&lang, "lang", "", fmt.Sprintf("Code of the language the query is stemmed in, `%s` detects the language of the query. Defaults to the language of the index. Supported languages: %v", analyzer.AutoLanguage, stemmer.Languages()))
+	configSynonymsFlags(flg)
+	configExpansionFlags(flg)
+	configScorerFlags(flg)
 	return flg
 }
 
@@ -53,21 +127,52 @@ func configServeFlagSet() *flag.FlagSet {
 	flg := flag.NewFlagSet(serveSubCommand, flag.ExitOnError)
 	flg.StringVar(&dbPath, "db", defaultDBPath, "Path of db to store the index. Supported formats: [.db, .json]")
 	flg.StringVar(&addr, "addr", defaultAddr, "Address to serve the server on")
+	flg.StringVar(&adminToken, "adminToken", "", "Bearer token required by the admin endpoints (/api/admin/...), admin endpoints are disabled when empty")
+	flg.StringVar(&watchDir, "watch", "", "Directory to watch for changes, keeping the index up to date while serving")
+	flg.DurationVar(&debounce, "debounce", defaultDebounce, "Quiet period to wait for, before applying a burst of changes to the index")
+	flg.StringVar(&lang, "lang", "", fmt.Sprintf("Code of the language the query is stemmed in, `%s` detects the language of the query. Defaults to the language of the index. Supported languages: %v", analyzer.AutoLanguage, stemmer.Languages()))
+	configSynonymsFlags(flg)
+	configExpansionFlags(flg)
+	configScorerFlags(flg)
+	return flg
+}
+
+func configWatchFlagSet() *flag.FlagSet {
+	flg := flag.NewFlagSet(watchSubCommand, flag.ExitOnError)
+	flg.StringVar(&dirPath, "dir", "", "Directory containing the files")
+	flg.StringVar(&dbPath, "db", defaultDBPath, "Path of db to store the index. Supported formats: [.db, .json]")
+	flg.StringVar(&analyzerName, "analyzer", "", fmt.Sprintf("Analyzer splitting the texts into tokens, a new index is built with the `%s` analyzer when empty. Supported analyzers: %v", analyzer.DefaultConfigName, analyzer.ConfigNames()))
+	flg.StringVar(&lang, "lang", "", fmt.Sprintf("Code of the language the words are stemmed in, `%s` detects the language of each document. Defaults to the language of the index, a new index detects the language of each document. Supported languages: %v", analyzer.AutoLanguage, stemmer.Languages()))
+	flg.StringVar(&stopwords, "stopwords", "", fmt.Sprintf("Stopwords dropped from the documents and the queries: \"%s\" keeps all the words, a language code selects its built-in stopwords and any other value is the path of a file listing the stopwords, one per line. Defaults to the stopwords of the index or of the language for a new index", analyzer.NoStopwords))
+	flg.StringVar(&accents, "accents", "", fmt.Sprintf("Treatment of the diacritics (résumé, naïve): \"%s\" strips them, \"%s\" strips them and also indexes the words with them, ranking the queries with the same diacritics higher, \"%s\" keeps them. Defaults to the accents of the index or of the analyzer for a new index", analyzer.FoldAccents, analyzer.BothAccents, analyzer.KeepAccents))
+	flg.StringVar(&ngramSizes, "ngrams", "", fmt.Sprintf("Ngrams of the words indexed in the \"%s\" field: comma separated sizes (3,5,7), the sizes prefixed by \"edge:\" for the ngrams prefixing the words (edge:2,3,4) or \"%s\". Defaults to the ngrams of the index or of the analyzer for a new index", ngramField, analyzer.NoNgrams))
+	flg.DurationVar(&debounce, "debounce", defaultDebounce, "Quiet period to wait for, before applying a burst of changes to the index")
+	return flg
+}
+
+func configRemoveFlagSet() *flag.FlagSet {
+	flg := flag.NewFlagSet(removeSubCommand, flag.ExitOnError)
+	flg.StringVar(&dbPath, "db", defaultDBPath, "Path of db to store the index. Supported formats: [.db, .json]")
+	flg.StringVar(&docPath, "doc", "", "Path of the document to remove from the index, all the documents under it are removed if it is a directory")
 	return flg
 }
 
 var (
-	buildFlagSet *flag.FlagSet = configBuildFlagSet()
-	queryFlagSet               = configQueryFlagSet()
-	serveFlagSet               = configServeFlagSet()
+	buildFlagSet  *flag.FlagSet = configBuildFlagSet()
+	queryFlagSet                = configQueryFlagSet()
+	serveFlagSet                = configServeFlagSet()
+	removeFlagSet               = configRemoveFlagSet()
+	watchFlagSet                = configWatchFlagSet()
 )
 
 func usage(program string) {
 	fmt.Printf("Usage: ./%s <SUBCOMMAND> <FLAGS>\n", program)
 	fmt.Printf("  SUBCOMMANDS:\n")
-	fmt.Printf("    - %s: for building index db on documents present in a given directory\n", buildSubCommand)
-	fmt.Printf("    - %s: for finding closest matching document for a given query using tf-idf\n", querySubCommand)
+	fmt.Printf("    - %s: for building (or incrementally updating) index db on documents present in a given directory\n", buildSubCommand)
+	fmt.Printf("    - %s: for finding closest matching document for a given query using tf-idf or bm25\n", querySubCommand)
 	fmt.Printf("    - %s: for serving index db on web\n", serveSubCommand)
+	fmt.Printf("    - %s: for removing documents from the index db\n", removeSubCommand)
+	fmt.Printf("    - %s: for keeping the index db up to date with the changes in a given directory\n", watchSubCommand)
 	fmt.Printf("    - %s: see help\n", helpSubCommand)
 	fmt.Println()
 	buildFlagSet.Usage()
@@ -75,118 +180,823 @@ func usage(program string) {
 	queryFlagSet.Usage()
 	fmt.Println()
 	serveFlagSet.Usage()
+	fmt.Println()
+	removeFlagSet.Usage()
+	fmt.Println()
+	watchFlagSet.Usage()
 	os.Exit(1)
 }
 
-func ngrams(token string, n uint) []string {
-	var ret []string
-	var ngram string
-	len_ := uint(len(token))
-	for i := uint(0); i+n < len_; i++ {
-		ngram = token[i : i+n]
-		ret = append(ret, ngram)
+// Returns the words of the text analyzed in the language by the analyzer of the index, along with their positions in
+// the text
+func words(text string, language string) ([]string, []uint) {
+	var tokens []string
+	var positions []uint
+	for _, word := range textAnalyzer.WordsIn(text, language) {
+		tokens = append(tokens, word.Text)
+		positions = append(positions, word.Position)
 	}
-	return ret
+	return tokens, positions
 }
 
-func tokenize(text string) []string {
-	t := tokenizer.SimpleTokenizerFromString(text)
+// Returns the tokens of the text analyzed in the language by the analyzer of the index, i.e. the words along with their
+// expansions, and the words as written, see surfaceWords. The expansions share the position of their word and the
+// ngrams are in their own field. The source code is split into identifiers, see analyzer.Config.SourceTokenizer
+func tokenizeWithPositions(text string, language string, source bool) ([]string, []uint, []string) {
+	analyze := textAnalyzer.AnalyzeIn
+	if source {
+		analyze = textAnalyzer.AnalyzeSourceIn
+	}
 	var tokens []string
-	var stem stemmer.Stemmer = &snowball.EnglishStemmer{}
-	for t.Contains() {
-		token := strings.TrimSpace(strings.ToUpper(t.NextToken()))
-		if _, ok := STOPWORDS[token]; !ok {
-			token = stem.Stem(token)
-			tokens = append(tokens, token)
-			tokens = append(tokens, ngrams(token, 3)...)
-			tokens = append(tokens, ngrams(token, 5)...)
-			tokens = append(tokens, ngrams(token, 7)...)
+	var positions []uint
+	var words []string
+	for _, token := range analyze(text, language) {
+		if token.Ngram {
+			token.Text = fieldToken(ngramField, token.Text)
+		}
+		tokens = append(tokens, token.Text)
+		positions = append(positions, token.Position)
+		if !token.Expansion {
+			words = append(words, textAnalyzer.Normalize(text[token.Start:token.End]))
+		}
+	}
+	return tokens, positions, words
+}
+
+// Returns the phrase of the words of the text in the field, nil when the text only has stopwords. Only the words of
+// the body are expanded, with the expansions produced by the analyzer of the index. The ngrams are boosted by the
+// boost of their field
+func mkPhrase(field string, text string, language string) *tfIndex.PhraseQuery {
+	tokens, positions := fieldWords(field, text, language)
+	if len(tokens) == 0 {
+		return nil
+	}
+	phrase := &tfIndex.PhraseQuery{Tokens: tokens, Positions: positions, Boost: fieldBoosts[field]}
+	for i := range tokens {
+		phrase.Positions[i] -= positions[0]
+	}
+	if field == bodyField {
+		for _, token := range textAnalyzer.AnalyzeIn(text, language) {
+			switch {
+			case token.Ngram:
+				expansion := tfIndex.Expansion{Token: fieldToken(ngramField, token.Text), Boost: fieldBoosts[ngramField]}
+				phrase.Expansions = append(phrase.Expansions, expansion)
+			case token.Expansion:
+				phrase.Expansions = append(phrase.Expansions, tfIndex.Expansion{Token: token.Text})
+			default:
+			}
 		}
 	}
-	return tokens
+	return phrase
+}
+
+// Returns the fields searched by a word or a phrase scoped to the field
+func searchedFields(field string) []string {
+	if field == "" {
+		return defaultFields
+	}
+	return []string{field}
+}
+
+// Returns the field and the text of a word or a phrase
+func fieldAndText(node queryParser.Node) (string, string) {
+	switch node := node.(type) {
+	case *queryParser.Term:
+		return node.Field, node.Text
+	case *queryParser.Phrase:
+		return node.Field, node.Text
+	default:
+	}
+	return "", node.String()
+}
+
+// Returns the only query or the queries as alternatives, nil when there are no queries
+func anyOf(searchQueries []tfIndex.SearchQuery) tfIndex.SearchQuery {
+	switch len(searchQueries) {
+	case 0:
+		return nil
+	case 1:
+		return searchQueries[0]
+	default:
+	}
+	return tfIndex.BooleanQuery{Optional: searchQueries}
+}
+
+func mkSearchQueries(nodes []queryParser.Node, language string) ([]tfIndex.SearchQuery, error) {
+	ret := []tfIndex.SearchQuery{}
+	for _, node := range nodes {
+		searchQuery, err := mkSearchQueryFromNode(node, language)
+		if err != nil {
+			return nil, err
+		}
+		if searchQuery != nil {
+			ret = append(ret, searchQuery)
+		}
+	}
+	return ret, nil
+}
+
+// Analyzes the words of the syntax tree in the language into the query evaluated by the index, nil when the node only
+// has stopwords. Words and phrases which are not scoped to a field are searched in all the default fields
+func mkSearchQueryFromNode(node queryParser.Node, language string) (tfIndex.SearchQuery, error) {
+	switch node := node.(type) {
+	case *queryParser.Term:
+		alternatives := []tfIndex.SearchQuery{}
+		for _, field := range searchedFields(node.Field) {
+			// a word can be split into multiple tokens (e.g. `foo-bar`), which are then searched as a phrase
+			if phrase := mkPhrase(field, node.Text, language); phrase != nil {
+				alternatives = append(alternatives, phraseOrTerm(*phrase))
+			}
+		}
+		alternatives = append(alternatives, synonymQueries(searchedFields(node.Field), node.Text, language)...)
+		return anyOf(alternatives), nil
+	case *queryParser.Phrase:
+		alternatives := []tfIndex.SearchQuery{}
+		for _, field := range searchedFields(node.Field) {
+			if phrase := mkPhrase(field, node.Text, language); phrase != nil {
+				alternatives = append(alternatives, *phrase)
+			}
+		}
+		alternatives = append(alternatives, synonymQueries(searchedFields(node.Field), node.Text, language)...)
+		return anyOf(alternatives), nil
+	case *queryParser.Fuzzy:
+		alternatives := []tfIndex.SearchQuery{}
+		for _, field := range searchedFields(node.Field) {
+			tokens, _ := fieldWords(field, node.Text, language)
+			if len(tokens) > 1 {
+				return nil, fmt.Errorf("mkSearchQuery: `%s` must be a single word to be fuzzy, got %d words", node, len(tokens))
+			}
+			if len(tokens) == 1 {
+				prefix := fieldToken(field, "")
+				fuzzy := tfIndex.FuzzyQuery{Prefix: prefix, Token: strings.TrimPrefix(tokens[0], prefix), MaxDistance: node.Distance, Boost: fieldBoosts[field]}
+				alternatives = append(alternatives, fuzzy)
+			}
+		}
+		return anyOf(alternatives), nil
+	case *queryParser.Wildcard:
+		alternatives := []tfIndex.SearchQuery{}
+		for _, field := range searchedFields(node.Field) {
+			// the patterns are matched against the tokens as normalized by the analyzer, the keywords are only lowercased
+			pattern := textAnalyzer.Normalize(node.Pattern)
+			if field == extField || field == langField {
+				pattern = strings.ToLower(strings.TrimPrefix(node.Pattern, "."))
+			}
+			wildcard := tfIndex.WildcardQuery{Prefix: fieldToken(field, ""), Pattern: pattern, MaxExpansions: maxExpansions, Boost: fieldBoosts[field]}
+			alternatives = append(alternatives, wildcard)
+		}
+		return anyOf(alternatives), nil
+	case *queryParser.Near:
+		leftField, leftText := fieldAndText(node.Left)
+		rightField, rightText := fieldAndText(node.Right)
+		if leftField != "" && rightField != "" && leftField != rightField {
+			return nil, fmt.Errorf("mkSearchQuery: operands of `%s` must be in the same field, got `%s` and `%s`", node, leftField, rightField)
+		}
+		field := leftField
+		if field == "" {
+			field = rightField
+		}
+		alternatives := []tfIndex.SearchQuery{}
+		for _, field := range searchedFields(field) {
+			left, right := mkPhrase(field, leftText, language), mkPhrase(field, rightText, language)
+			switch {
+			case left != nil && right != nil:
+				alternatives = append(alternatives, tfIndex.NearQuery{Left: *left, Right: *right, Distance: node.Distance, Boost: fieldBoosts[field]})
+			// the proximity constraint vanishes when one of its operands only has stopwords
+			case left != nil:
+				alternatives = append(alternatives, *left)
+			case right != nil:
+				alternatives = append(alternatives, *right)
+			default:
+			}
+		}
+		return anyOf(alternatives), nil
+	case *queryParser.Or:
+		children, err := mkSearchQueries(node.Children, language)
+		if err != nil || len(children) == 0 {
+			return nil, err
+		}
+		return tfIndex.BooleanQuery{Optional: children}, nil
+	case *queryParser.And:
+		var required, excluded []queryParser.Node
+		for _, child := range node.Children {
+			if not, ok := child.(*queryParser.Not); ok {
+				excluded = append(excluded, not.Child)
+			} else {
+				required = append(required, child)
+			}
+		}
+		return mkBooleanQuery(nil, required, excluded, language)
+	case *queryParser.Group:
+		return mkBooleanQuery(node.Optional, node.Required, node.Excluded, language)
+	default:
+	}
+	return nil, nil
+}
+
+func mkBooleanQuery(optional []queryParser.Node, required []queryParser.Node, excluded []queryParser.Node, language string) (tfIndex.SearchQuery, error) {
+	booleanQuery := tfIndex.BooleanQuery{}
+	var err error
+	if booleanQuery.Optional, err = mkSearchQueries(optional, language); err != nil {
+		return nil, err
+	}
+	booleanQuery.Optional = append(booleanQuery.Optional, multiWordSynonymQueries(optional, language)...)
+	if booleanQuery.Required, err = mkSearchQueries(required, language); err != nil {
+		return nil, err
+	}
+	if booleanQuery.Excluded, err = mkSearchQueries(excluded, language); err != nil {
+		return nil, err
+	}
+	if len(booleanQuery.Optional)+len(booleanQuery.Required) == 0 {
+		return nil, nil
+	}
+	return booleanQuery, nil
+}
+
+// Returns the words and the phrases of the syntax tree, the fields and the operators are left out
+func queryText(node queryParser.Node) string {
+	switch node := node.(type) {
+	case *queryParser.Term:
+		return node.Text
+	case *queryParser.Phrase:
+		return node.Text
+	case *queryParser.Fuzzy:
+		return node.Text
+	case *queryParser.Near:
+		return queryText(node.Left) + " " + queryText(node.Right)
+	case *queryParser.And:
+		return queryTexts(node.Children)
+	case *queryParser.Or:
+		return queryTexts(node.Children)
+	case *queryParser.Not:
+		return queryText(node.Child)
+	case *queryParser.Group:
+		return strings.Join([]string{queryTexts(node.Optional), queryTexts(node.Required), queryTexts(node.Excluded)}, " ")
+	default:
+	}
+	return ""
+}
+
+func queryTexts(nodes []queryParser.Node) string {
+	texts := []string{}
+	for _, node := range nodes {
+		texts = append(texts, queryText(node))
+	}
+	return strings.Join(texts, " ")
+}
+
+// Returns the language the words of the query are analyzed in, see analyzer.Analyzer.DetectLanguage
+func queryLanguage(node queryParser.Node) string {
+	return textAnalyzer.DetectLanguage(queryText(node))
+}
+
+// Parses the query string returning both its syntax tree and the query evaluated by the index
+func mkSearchQuery(queryString string) (tfIndex.SearchQuery, queryParser.Node, error) {
+	node, err := queryParser.Parse(queryString, allFields...)
+	if err != nil {
+		return nil, nil, err
+	}
+	searchQuery, err := mkSearchQueryFromNode(node, queryLanguage(node))
+	return searchQuery, node, err
+}
+
+// Checks that the index is of the current format. The outdated indexes are emptied while building, so that all the
+// documents are indexed again, and are refused otherwise. The indexes without a version are of the legacy format, which
+// recorded the tokens without the documents
+func checkFormatVersion(index tfIndex.TFIndex, subcommand string) error {
+	version, ok, err := index.Metadata(formatVersionMetadataKey)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		version = "1"
+	}
+	if version == indexFormatVersion {
+		return nil
+	}
+	empty, err := index.IsEmpty()
+	if err != nil {
+		return err
+	}
+	if !empty {
+		if subcommand != buildSubCommand && subcommand != watchSubCommand {
+			return fmt.Errorf("checkFormatVersion: index `%s` is of the outdated format version %s, rebuild it using the `%s` subcommand", dbPath, version, buildSubCommand)
+		}
+		slog.Infof("Index `%s` is of the outdated format version %s, rebuilding it...", dbPath, version)
+		if err := index.Clear(); err != nil {
+			return err
+		}
+	}
+	return index.SetMetadata(formatVersionMetadataKey, indexFormatVersion)
+}
+
+// Returns the named configuration of the analyzer in the language, the language of the configuration when empty
+func mkConfig(name string, language string) (analyzer.Config, error) {
+	config, err := analyzer.LookupConfig(name)
+	if err != nil || language == "" {
+		return config, err
+	}
+	return config.WithLanguage(language)
+}
+
+// Returns the configuration of the analyzer recorded in the index, the default analyzer when none is recorded
+func indexConfig(index tfIndex.TFIndex) (config analyzer.Config, recorded bool, err error) {
+	configJSON, recorded, err := index.Metadata(analyzerMetadataKey)
+	if err != nil {
+		return config, false, err
+	}
+	if !recorded {
+		config, err = analyzer.LookupConfig(analyzer.DefaultConfigName)
+		return config, false, err
+	}
+	if err := json.Unmarshal([]byte(configJSON), &config); err != nil {
+		return config, true, fmt.Errorf("indexConfig: cannot read the analyzer of the index: %w", err)
+	}
+	return config, true, nil
+}
+
+// Returns the configuration of the analyzer of the queries, i.e. the analyzer of the index in the language given by
+// -lang. The index being kept up to date while serving cannot change its language
+func queryConfig(config analyzer.Config) (analyzer.Config, error) {
+	if lang == "" {
+		return config, nil
+	}
+	if watchDir != "" && lang != config.Language() {
+		return config, fmt.Errorf("queryConfig: index `%s` is built in the language `%s`, it cannot be kept up to date in the language `%s`", dbPath, config.Language(), lang)
+	}
+	return config.WithLanguage(lang)
+}
+
+// Checks that the analyzer given by the flags is the analyzer the documents of the index are built with
+func checkConfig(config analyzer.Config) error {
+	if analyzerName != "" && analyzerName != config.Name {
+		return fmt.Errorf("checkConfig: index `%s` is built with the analyzer `%s`, remove it to rebuild it with the analyzer `%s`", dbPath, config.Name, analyzerName)
+	}
+	if lang != "" && lang != config.Language() {
+		return fmt.Errorf("checkConfig: index `%s` is built in the language `%s`, remove it to rebuild it in the language `%s`", dbPath, config.Language(), lang)
+	}
+	if stopwords != "" {
+		stopwordsConfig, err := config.WithStopwords(stopwords)
+		if err != nil {
+			return err
+		}
+		if !stopwordsConfig.SameStopwords(config) {
+			return fmt.Errorf("checkConfig: index `%s` is built dropping %s, remove it to rebuild it dropping %s", dbPath, config.DescribeStopwords(), stopwordsConfig.DescribeStopwords())
+		}
+	}
+	if accents != "" {
+		if _, err := config.WithAccents(accents); err != nil {
+			return err
+		}
+	}
+	if accents != "" && accents != config.Accents() {
+		return fmt.Errorf("checkConfig: index `%s` is built with the accents `%s`, remove it to rebuild it with the accents `%s`", dbPath, config.Accents(), accents)
+	}
+	if ngramSizes != "" {
+		ngramsConfig, err := config.WithNgrams(ngramSizes)
+		if err != nil {
+			return err
+		}
+		if ngramsConfig.Ngrams() != config.Ngrams() {
+			return fmt.Errorf("checkConfig: index `%s` is built with the ngrams `%s`, remove it to rebuild it with the ngrams `%s`", dbPath, config.Ngrams(), ngramsConfig.Ngrams())
+		}
+	}
+	return nil
+}
+
+// Returns the configuration of the analyzer of an empty index given by the flags. It takes the current definition of
+// the recorded analyzer, in the language the index was built in, along with the stopwords, the accents and the ngrams
+// chosen for the index. A new index detects the language of each document
+func emptyIndexConfig(config analyzer.Config, recorded bool) (analyzer.Config, error) {
+	if analyzerName != "" {
+		config.Name = analyzerName
+	}
+	recordedConfig, recordedLanguage := config, config.Language()
+	if lang == "" && recorded {
+		lang = recordedLanguage
+	} else if lang == "" {
+		lang = analyzer.AutoLanguage
+	}
+	config, err := mkConfig(config.Name, lang)
+	if err != nil {
+		return config, err
+	}
+	namedConfig, err := mkConfig(recordedConfig.Name, recordedLanguage)
+	keepChoices := recorded && err == nil
+	if stopwords != "" {
+		if config, err = config.WithStopwords(stopwords); err != nil {
+			return config, err
+		}
+	} else if keepChoices && !recordedConfig.SameStopwords(namedConfig) {
+		config = config.WithStopwordsOf(recordedConfig)
+	}
+	// the indexes built before the accents were folded keep them, hence keeping them is never carried over
+	if accents == "" && keepChoices && recordedConfig.Accents() != namedConfig.Accents() && recordedConfig.Accents() != analyzer.KeepAccents {
+		accents = recordedConfig.Accents()
+	}
+	if accents != "" {
+		if config, err = config.WithAccents(accents); err != nil {
+			return config, err
+		}
+	}
+	if ngramSizes == "" && keepChoices && recordedConfig.Ngrams() != namedConfig.Ngrams() {
+		ngramSizes = recordedConfig.Ngrams()
+	}
+	if ngramSizes != "" {
+		if config, err = config.WithNgrams(ngramSizes); err != nil {
+			return config, err
+		}
+	}
+	return config, nil
+}
+
+// Records the configuration of textAnalyzer in the index
+func saveAnalyzer(index tfIndex.TFIndex) error {
+	configJSON, err := textAnalyzer.ToJSON()
+	if err != nil {
+		return err
+	}
+	return index.SetMetadata(analyzerMetadataKey, configJSON)
+}
+
+// Loads the analyzer the index is built with into textAnalyzer, the indexes built before the analyzers were recorded
+// use the default analyzer. Building an index records its analyzer, which cannot change once the index has documents.
+// The queries are analyzed in the language given by -lang, which defaults to the language of the index. The stopwords
+// given by -stopwords, the accents given by -accents and the ngrams given by -ngrams are recorded along with the
+// analyzer
+func loadAnalyzer(index tfIndex.TFIndex, subcommand string) error {
+	var err error
+	if lang != "" {
+		if lang, err = analyzer.LanguageCode(lang); err != nil {
+			return err
+		}
+	}
+	config, recorded, err := indexConfig(index)
+	if err != nil {
+		return err
+	}
+	if subcommand != buildSubCommand && subcommand != watchSubCommand {
+		// the queries are analyzed in the language, while the index keeps its analyzer
+		if config, err = queryConfig(config); err != nil {
+			return err
+		}
+		textAnalyzer, err = analyzer.New(config)
+		return err
+	}
+	docs, err := index.Documents()
+	if err != nil {
+		return err
+	}
+	if len(docs) > 0 {
+		err = checkConfig(config)
+	} else {
+		config, err = emptyIndexConfig(config, recorded)
+		if err == nil {
+			slog.Infof("Dropping %s, accents: %s, ngrams: %s", config.DescribeStopwords(), config.Accents(), config.Ngrams())
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if textAnalyzer, err = analyzer.New(config); err != nil {
+		return err
+	}
+	return saveAnalyzer(index)
 }
 
 func mkIndex(program string, subcommand string) tfIndex.TFIndex {
 	parts := strings.Split(dbPath, ".")
 	ext := parts[len(parts)-1]
-	if (subcommand == querySubCommand) || (subcommand == serveSubCommand) {
+	existingIndexRequired := subcommand != buildSubCommand && subcommand != watchSubCommand
+	if existingIndexRequired {
 		if _, err := os.Open(dbPath); err != nil {
 			slog.Fatal(err)
 		}
 	}
+	var index tfIndex.TFIndex
 	switch ext {
 	case "db":
-		return tfIndex.NewSQLiteTFIndex(dbPath)
+		sqliteTFIndex := tfIndex.NewSQLiteTFIndex(dbPath)
+		// the queries only read the index, which is refused unless it is of the current format
+		if !existingIndexRequired {
+			if err := sqliteTFIndex.Migrate(); err != nil {
+				slog.Fatal(err)
+			}
+		}
+		index = sqliteTFIndex
 	case "json":
-		index, err := tfIndex.SimpleTFINdexFromJSON(dbPath)
+		simpleTFIndex, err := tfIndex.SimpleTFINdexFromJSON(dbPath)
 		if err != nil {
-			if (subcommand == querySubCommand) || (subcommand == serveSubCommand) {
+			if existingIndexRequired && errors.Is(err, tfIndex.ErrLegacyFormat) {
+				slog.Fatal(fmt.Errorf("%w, rebuild it using the `%s` subcommand", err, buildSubCommand))
+			}
+			if existingIndexRequired {
 				slog.Fatal(err)
-				return index
 			}
-			index = tfIndex.NewSimpleTFIndex()
+			if errors.Is(err, tfIndex.ErrLegacyFormat) {
+				slog.Infof("%s, rebuilding it", err)
+			}
+			simpleTFIndex = tfIndex.NewSimpleTFIndex()
 		}
-		return index
+		index = simpleTFIndex
 	default:
+		fmt.Printf("Unknown extension `%s` found\n", dbPath)
+		usage(program)
+		return nil
 	}
-	fmt.Printf("Unknown extension `%s` found\n", dbPath)
-	usage(program)
-	return nil
+	if err := checkFormatVersion(index, subcommand); err != nil {
+		slog.Fatal(err)
+	}
+	if err := loadAnalyzer(index, subcommand); err != nil {
+		slog.Fatal(err)
+	}
+	return index
 }
 
-func build(program string) {
-	buildFlagSet.Parse(os.Args)
-	slog.Infof("Building index for directory `%s`...", dirPath)
-	fileContentsCH, err := fileContents.FromDirectory(dirPath, fileBufferSize)
+// Changes in the files since they were last indexed
+type fileChanges struct {
+	added     []string
+	modified  []string
+	deleted   []string
+	unchanged uint
+	metas     map[string]tfIndex.DocMeta
+	// Metadata of the unchanged files whose size or modification time changed, e.g. touched files
+	touched map[string]tfIndex.DocMeta
+}
+
+func (changes fileChanges) isEmpty() bool {
+	return len(changes.added)+len(changes.modified)+len(changes.deleted)+len(changes.touched) == 0
+}
+
+// Checks if the document is the path itself or lies under it
+func isDocAt(docId string, absPath string) bool {
+	return docId == absPath || strings.HasPrefix(docId, absPath+string(filepath.Separator))
+}
+
+// Returns the regular files present at the paths, a path can either be a file or a directory
+func statPaths(absPaths []string) ([]fileContents.FileStat, error) {
+	var ret []fileContents.FileStat
+	seenBefore := map[string]bool{}
+	for _, absPath := range absPaths {
+		fi, err := os.Stat(absPath)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("statPaths: cannot stat `%s`: %w", absPath, err)
+		}
+		var fileStats []fileContents.FileStat
+		switch {
+		case fi.IsDir():
+			fileStats, err = fileContents.StatDirectory(absPath)
+			if err != nil {
+				return nil, err
+			}
+		case fi.Mode().IsRegular():
+			fileStats = []fileContents.FileStat{{FilePath: absPath, Size: fi.Size(), ModTime: fi.ModTime().UnixNano()}}
+		default:
+		}
+		for _, fileStat := range fileStats {
+			if !seenBefore[fileStat.FilePath] {
+				seenBefore[fileStat.FilePath] = true
+				ret = append(ret, fileStat)
+			}
+		}
+	}
+	return ret, nil
+}
+
+// Detects the files at the paths which were added, modified or deleted since they were last indexed
+func detectChanges(paths []string, indexedDocs map[string]tfIndex.DocMeta) (fileChanges, error) {
+	changes := fileChanges{metas: map[string]tfIndex.DocMeta{}, touched: map[string]tfIndex.DocMeta{}}
+	absPaths := make([]string, len(paths))
+	for i, path := range paths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return changes, fmt.Errorf("detectChanges: cannot resolve the absolute path of `%s`: %w", path, err)
+		}
+		absPaths[i] = absPath
+	}
+	fileStats, err := statPaths(absPaths)
 	if err != nil {
-		slog.Fatal(err)
+		return changes, err
+	}
+	present := map[string]bool{}
+	for _, fileStat := range fileStats {
+		present[fileStat.FilePath] = true
+		indexedMeta, indexed := indexedDocs[fileStat.FilePath]
+		if indexed && indexedMeta.Size == fileStat.Size && indexedMeta.ModTime == fileStat.ModTime {
+			changes.unchanged++
+			continue
+		}
+		hash, err := fileContents.Hash(fileStat.FilePath)
+		if err != nil {
+			slog.Errorf("detectChanges: error occurred for file `%s`: %s", fileStat.FilePath, err)
+			continue
+		}
+		if indexed && indexedMeta.Hash == hash {
+			// the stat is refreshed so that the file is not hashed again by the next builds
+			indexedMeta.Size, indexedMeta.ModTime = fileStat.Size, fileStat.ModTime
+			changes.touched[fileStat.FilePath] = indexedMeta
+			changes.unchanged++
+			continue
+		}
+		changes.metas[fileStat.FilePath] = tfIndex.DocMeta{Size: fileStat.Size, ModTime: fileStat.ModTime, Hash: hash}
+		if indexed {
+			changes.modified = append(changes.modified, fileStat.FilePath)
+		} else {
+			changes.added = append(changes.added, fileStat.FilePath)
+		}
+	}
+	for docId := range indexedDocs {
+		if present[docId] {
+			continue
+		}
+		for _, absPath := range absPaths {
+			if isDocAt(docId, absPath) {
+				changes.deleted = append(changes.deleted, docId)
+				break
+			}
+		}
 	}
+	return changes, nil
+}
+
+// Reads and tokenizes the added and modified files
+func changedDocTokens(changes fileChanges) <-chan tfIndex.DocTokens {
+	fileContentsCH := fileContents.FromFilePaths(append(changes.added, changes.modified...), fileBufferSize)
 	fileTokensCH := make(chan tfIndex.DocTokens, fileBufferSize)
 	go func() {
 		defer close(fileTokensCH)
 		for fileContent := range fileContentsCH {
 			if fileContent.Err != nil {
-				slog.Errorf("build: error occurred for file `%s`: %s", fileContent.FilePath, fileContent.Err)
+				slog.Errorf("changedDocTokens: error occurred for file `%s`: %s", fileContent.FilePath, fileContent.Err)
 				continue
 			}
-			DocID := fileContent.FilePath
-			Tokens := tokenize(fileContent.Content)
-			fileTokensCH <- tfIndex.DocTokens{DocID: DocID, Tokens: Tokens}
+			fileTokensCH <- mkDocTokens(fileContent, changes.metas[fileContent.FilePath])
 		}
 	}()
+	return fileTokensCH
+}
+
+func logChanges(changes fileChanges) {
+	slog.Infof("Found %d new, %d modified, %d deleted and %d unchanged files", len(changes.added), len(changes.modified), len(changes.deleted), changes.unchanged)
+}
+
+func build(program string) {
+	buildFlagSet.Parse(os.Args)
+	slog.Infof("Building index for directory `%s`...", dirPath)
 	index := mkIndex(program, buildSubCommand)
-	err = index.BulkUpdateChan(fileTokensCH)
+	indexedDocs, err := index.Documents()
+	if err != nil {
+		slog.Fatal(err)
+	}
+	changes, err := detectChanges([]string{dirPath}, indexedDocs)
+	if err != nil {
+		slog.Fatal(err)
+	}
+	logChanges(changes)
+	if changes.isEmpty() {
+		slog.Infof("Index `%s` is already up to date", dbPath)
+		return
+	}
+	err = index.BulkDelete(changes.deleted)
+	if err != nil {
+		slog.Fatal(err)
+	}
+	err = index.UpdateMetas(changes.touched)
+	if err != nil {
+		slog.Fatal(err)
+	}
+	err = index.BulkUpdateChan(changedDocTokens(changes))
 	if err != nil {
 		slog.Fatal(err)
 	}
 	slog.Info("Successfully build the index")
 	slog.Infof("Saving index to `%s`...", dbPath)
+	if err := saveIndex(index); err != nil {
+		slog.Fatal(err)
+	}
+	slog.Infof("Index saved to `%s`", dbPath)
+}
+
+func saveIndex(index tfIndex.TFIndex) error {
 	switch index.(type) {
 	case *tfIndex.SimpleTFINdex:
-		err := index.(*tfIndex.SimpleTFINdex).DumpToJSON(dbPath)
-		if err != nil {
-			slog.Fatal(err)
-		}
+		return index.(*tfIndex.SimpleTFINdex).DumpToJSON(dbPath)
 	case *tfIndex.SQLiteTFIndex:
 		// Already saving to DB directory, when doing bulk update, hence no need to do it here
-		break
+		return nil
 	default:
-		slog.Fatal("Unreachable!")
 	}
-	slog.Infof("Index saved to `%s`", dbPath)
+	return fmt.Errorf("saveIndex: unknown index type %T", index)
+}
+
+// Returns the docIds of the indexed documents at the path, i.e. the document itself or all the documents under it when it is a directory
+func indexedDocsAt(index tfIndex.TFIndex, path string) ([]string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("indexedDocsAt: cannot resolve the absolute path of `%s`: %w", path, err)
+	}
+	indexedDocs, err := index.Documents()
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for docId := range indexedDocs {
+		if isDocAt(docId, absPath) {
+			ret = append(ret, docId)
+		}
+	}
+	return ret, nil
+}
+
+func remove(program string) {
+	removeFlagSet.Parse(os.Args)
+	if docPath == "" {
+		fmt.Println("Did not provide the document to remove!")
+		usage(program)
+	}
+	index := mkIndex(program, removeSubCommand)
+	docIds, err := indexedDocsAt(index, docPath)
+	if err != nil {
+		slog.Fatal(err)
+	}
+	if len(docIds) == 0 {
+		slog.Infof("No documents found in the index `%s` for `%s`", dbPath, docPath)
+		return
+	}
+	err = index.BulkDelete(docIds)
+	if err != nil {
+		slog.Fatal(err)
+	}
+	if err := saveIndex(index); err != nil {
+		slog.Fatal(err)
+	}
+	slog.Infof("Removed %d documents from the index `%s`", len(docIds), dbPath)
+}
+
+// Renders the contribution of a token towards the score of a result
+func formatExplanation(explanation tfIndex.TokenExplanation) string {
+	token := explanation.Token
+	switch {
+	case explanation.ExpansionOf != "" && explanation.SynonymOf != "":
+		token = fmt.Sprintf("%s (expansion of %s, synonym of %s)", token, explanation.ExpansionOf, explanation.SynonymOf)
+	case explanation.ExpansionOf != "":
+		token = fmt.Sprintf("%s (expansion of %s)", token, explanation.ExpansionOf)
+	case explanation.SynonymOf != "":
+		token = fmt.Sprintf("%s (synonym of %s)", token, explanation.SynonymOf)
+	default:
+	}
+	return fmt.Sprintf(
+		"%.4f = %s %s: boost=%.2f * score=%.4f [tf=%d, df=%d, N=%d, idf=%.4f, tfWeight=%.4f, lengthNorm=%.4f (docLength=%d, avgDocLength=%.2f)]",
+		explanation.Contribution, explanation.Kind, token, explanation.Boost, explanation.Score,
+		explanation.Frequency, explanation.DocFrequency, explanation.TotalDocuments, explanation.IDF,
+		explanation.TFWeight, explanation.LengthNorm, explanation.DocLength, explanation.AvgDocLength,
+	)
 }
 
 func query(program string) {
 	queryFlagSet.Parse(os.Args)
+	scorer, err := tfIndex.NewScorer(scorerName, bm25K1, bm25B)
+	if err != nil {
+		slog.Fatal(err)
+	}
 	index := mkIndex(program, querySubCommand)
-	tokens := tokenize(queryString)
-	results, err := index.QueryTopN(tokens, topN)
+	loadSynonyms()
+	searchQuery, node, err := mkSearchQuery(queryString)
+	if err != nil {
+		slog.Fatal(err)
+	}
+	page, err := index.QueryPage(searchQuery, offset, topN, scorer, explain)
 	if err != nil {
 		slog.Fatal(err)
 	}
-	slog.Infof("Top %d results for the query: `%s`:", topN, queryString)
-	for _, result := range results {
+	suggestion, err := suggestQuery(index, queryString, node, searchQuery, page, offset, scorer)
+	if err != nil {
+		slog.Fatal(err)
+	}
+	tokens := map[string]bool{}
+	if showSnippets {
+		if err := highlightedTokens(index, node, queryLanguage(node), tokens); err != nil {
+			slog.Fatal(err)
+		}
+	}
+	if suggestion != "" {
+		slog.Infof("Did you mean: `%s`?", suggestion)
+	}
+	if len(page.Results) == 0 {
+		slog.Infof("No results for the query: `%s` (scorer: %s), %d results in total", queryString, scorer.Name(), page.Total)
+	} else {
+		slog.Infof("Results %d to %d of %d for the query: `%s` (scorer: %s):", offset+1, offset+uint(len(page.Results)), page.Total, queryString, scorer.Name())
+	}
+	for _, result := range page.Results {
 		slog.Infof("Score: %.2f, Doc: `%s`", result.Score, result.DocID)
+		for _, snippet := range docSnippets(result.DocID, tokens) {
+			slog.Infof("    %s", snippet.terminal())
+		}
+		for _, explanation := range result.Explanation {
+			slog.Infof("    %s", formatExplanation(explanation))
+		}
 	}
 }
 
@@ -245,13 +1055,39 @@ func handleLogoPNG(w http.ResponseWriter, r *http.Request) {
 type searchRequest struct {
 	Search string `json:"search"`
 	TopN   uint   `json:"topN"`
+	// Number of the top results to skip, for requesting the following pages of topN results
+	Offset uint     `json:"offset"`
+	Scorer string   `json:"scorer"`
+	K1     *float64 `json:"k1"`
+	B      *float64 `json:"b"`
+	// Snippets of the results are returned unless disabled
+	Snippets *bool `json:"snippets"`
+	Explain  bool  `json:"explain"`
 }
 
-type searchResponse struct {
+type searchHit struct {
 	DocID string  `json:"docId"`
 	Score float64 `json:"score"`
+	// Fragments of the document as html, with the matched words marked by <mark> spans
+	Snippets    []string                   `json:"snippets,omitempty"`
+	Explanation []tfIndex.TokenExplanation `json:"explanation,omitempty"`
+}
+
+type searchResponse struct {
+	Hits []searchHit `json:"hits"`
+	// Number of the results of the search over all the pages
+	Total  uint `json:"total"`
+	Offset uint `json:"offset"`
+	TopN   uint `json:"topN"`
+	// Offset of the next page, omitted on the last page
+	NextOffset *uint `json:"nextOffset,omitempty"`
+	// Corrected query suggested for the search, see suggestQuery
+	Suggestion string `json:"suggestion,omitempty"`
 }
 
+// Guards the index being served, searches hold the read lock while the modifications hold the write lock
+var indexLock sync.RWMutex
+
 func handleSearch(w http.ResponseWriter, r *http.Request, index tfIndex.TFIndex) {
 	switch r.Method {
 	case http.MethodPost:
@@ -260,25 +1096,65 @@ func handleSearch(w http.ResponseWriter, r *http.Request, index tfIndex.TFIndex)
 		var req searchRequest
 		err := decoder.Decode(&req)
 		if err != nil {
-			http.Error(w, "Could not interpret the request. Please send the POST request with JSON body as { search: <YOUR SEARCH TEXT HERE>, topN: <TOP N results> }", http.StatusBadRequest)
+			http.Error(w, "Could not interpret the request. Please send the POST request with JSON body as { search: <YOUR SEARCH TEXT HERE>, topN: <TOP N results>, offset: <OPTIONAL number of top results to skip>, scorer: <OPTIONAL tfidf | bm25>, k1: <OPTIONAL bm25 k1>, b: <OPTIONAL bm25 b>, snippets: <OPTIONAL true | false>, explain: <OPTIONAL true | false> }", http.StatusBadRequest)
+			return
+		}
+		if req.Scorer == "" {
+			req.Scorer = scorerName
+		}
+		if req.K1 == nil {
+			req.K1 = &bm25K1
+		}
+		if req.B == nil {
+			req.B = &bm25B
+		}
+		scorer, err := tfIndex.NewScorer(req.Scorer, *req.K1, *req.B)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		searchQuery, node, err := mkSearchQuery(req.Search)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		tokens := tokenize(req.Search)
 		topN := req.TopN
 		if topN == 0 {
 			topN = 10
 		}
-		results, err := index.QueryTopN(tokens, topN)
+		indexLock.RLock()
+		page, err := index.QueryPage(searchQuery, req.Offset, topN, scorer, req.Explain)
+		suggestion := ""
+		if err == nil {
+			suggestion, err = suggestQuery(index, req.Search, node, searchQuery, page, req.Offset, scorer)
+		}
+		tokens := map[string]bool{}
+		if err == nil && (req.Snippets == nil || *req.Snippets) {
+			err = highlightedTokens(index, node, queryLanguage(node), tokens)
+		}
+		indexLock.RUnlock()
+		var tooManyExpansions *tfIndex.TooManyExpansionsError
+		if errors.As(err, &tooManyExpansions) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		if err != nil {
 			errWithInternalServerError(w)
 			slog.Errorf("handleSearch: error occurred while searching for the query: %s", err)
 			return
 		}
-		searchResponses := []searchResponse{}
-		for _, result := range results {
-			searchResponses = append(searchResponses, searchResponse{result.DocID, result.Score})
+		response := searchResponse{Hits: []searchHit{}, Total: page.Total, Offset: req.Offset, TopN: topN, Suggestion: suggestion}
+		if nextOffset := req.Offset + uint(len(page.Results)); len(page.Results) > 0 && nextOffset < page.Total {
+			response.NextOffset = &nextOffset
+		}
+		for _, result := range page.Results {
+			hit := searchHit{DocID: result.DocID, Score: result.Score, Explanation: result.Explanation}
+			for _, snippet := range docSnippets(result.DocID, tokens) {
+				hit.Snippets = append(hit.Snippets, snippet.html())
+			}
+			response.Hits = append(response.Hits, hit)
 		}
-		bytes, err := json.Marshal(searchResponses)
+		bytes, err := json.Marshal(response)
 		if err != nil {
 			errWithInternalServerError(w)
 			slog.Errorf("handleSearch: unexpected error!: %s", err)
@@ -290,6 +1166,116 @@ func handleSearch(w http.ResponseWriter, r *http.Request, index tfIndex.TFIndex)
 	}
 }
 
+type suggestResponse struct {
+	Completions []completion   `json:"completions"`
+	Docs        []completedDoc `json:"docs"`
+}
+
+func handleSuggest(w http.ResponseWriter, r *http.Request, index tfIndex.TFIndex) {
+	switch r.Method {
+	case http.MethodGet:
+		setContentType(w, "application/json; charset=utf-8")
+		queryString := r.URL.Query().Get("q")
+		scorer, err := tfIndex.NewScorer(scorerName, bm25K1, bm25B)
+		if err != nil {
+			errWithInternalServerError(w)
+			slog.Errorf("handleSuggest: unexpected error!: %s", err)
+			return
+		}
+		indexLock.RLock()
+		completions, err := completeQuery(index, queryString)
+		var docs []completedDoc
+		if err == nil {
+			docs, err = completedDocs(index, queryString, completions, scorer)
+		}
+		indexLock.RUnlock()
+		var tooManyExpansions *tfIndex.TooManyExpansionsError
+		if errors.As(err, &tooManyExpansions) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			errWithInternalServerError(w)
+			slog.Errorf("handleSuggest: error occurred while completing the query: %s", err)
+			return
+		}
+		bytes, err := json.Marshal(suggestResponse{Completions: completions, Docs: docs})
+		if err != nil {
+			errWithInternalServerError(w)
+			slog.Errorf("handleSuggest: unexpected error!: %s", err)
+			return
+		}
+		w.Write(bytes)
+	default:
+		errWithMethodNotAllowed(w)
+	}
+}
+
+// Checks if the request is authorized to use the admin endpoints, responding with an error otherwise
+func authorizeAdmin(w http.ResponseWriter, r *http.Request) bool {
+	if adminToken == "" {
+		http.Error(w, "Admin endpoints are disabled. Please ask the server administrator to start the server with -adminToken", http.StatusForbidden)
+		return false
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+		http.Error(w, "Unauthorized!", http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
+
+type removeRequest struct {
+	DocPath string `json:"docPath"`
+}
+
+type removeResponse struct {
+	Removed []string `json:"removed"`
+}
+
+func handleRemove(w http.ResponseWriter, r *http.Request, index tfIndex.TFIndex) {
+	switch r.Method {
+	case http.MethodPost:
+		if !authorizeAdmin(w, r) {
+			return
+		}
+		setContentType(w, "application/json; charset=utf-8")
+		decoder := json.NewDecoder(r.Body)
+		var req removeRequest
+		err := decoder.Decode(&req)
+		if err != nil || req.DocPath == "" {
+			http.Error(w, "Could not interpret the request. Please send the POST request with JSON body as { docPath: <PATH OF THE DOCUMENT OR DIRECTORY TO REMOVE> }", http.StatusBadRequest)
+			return
+		}
+		indexLock.Lock()
+		docIds, err := indexedDocsAt(index, req.DocPath)
+		if err == nil {
+			err = index.BulkDelete(docIds)
+		}
+		if err == nil {
+			err = saveIndex(index)
+		}
+		indexLock.Unlock()
+		if err != nil {
+			errWithInternalServerError(w)
+			slog.Errorf("handleRemove: error occurred while removing `%s`: %s", req.DocPath, err)
+			return
+		}
+		if docIds == nil {
+			docIds = []string{}
+		}
+		bytes, err := json.Marshal(removeResponse{Removed: docIds})
+		if err != nil {
+			errWithInternalServerError(w)
+			slog.Errorf("handleRemove: unexpected error!: %s", err)
+			return
+		}
+		w.Write(bytes)
+	default:
+		errWithMethodNotAllowed(w)
+	}
+}
+
 type loggerMux struct {
 	handler http.Handler
 }
@@ -303,8 +1289,12 @@ func (l loggerMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 func serve(program string) {
 	serveFlagSet.Parse(os.Args)
+	if _, err := tfIndex.NewScorer(scorerName, bm25K1, bm25B); err != nil {
+		slog.Fatal(err)
+	}
 	slog.Infof("Serving index: `%s`", dbPath)
 	index := mkIndex(program, serveSubCommand)
+	loadSynonyms()
 	switch index.(type) {
 	case *tfIndex.SQLiteTFIndex:
 		defer index.(*tfIndex.SQLiteTFIndex).Close()
@@ -324,6 +1314,20 @@ func serve(program string) {
 	mux.HandleFunc("/api/search", func(w http.ResponseWriter, r *http.Request) {
 		handleSearch(w, r, index)
 	})
+	mux.HandleFunc("/api/suggest", func(w http.ResponseWriter, r *http.Request) {
+		handleSuggest(w, r, index)
+	})
+	mux.HandleFunc("/api/admin/remove", func(w http.ResponseWriter, r *http.Request) {
+		handleRemove(w, r, index)
+	})
+	if watchDir != "" {
+		w, err := watchDirectory(index, watchDir)
+		if err != nil {
+			slog.Fatal(err)
+		}
+		defer w.Close()
+		go applyWatchedChanges(index, w)
+	}
 	server := loggerMux{handler: mux}
 	slog.Infof("Listening on %s", addr)
 	slog.Fatal(http.ListenAndServe(addr, server))
@@ -349,6 +1353,10 @@ func main() {
 		query(program)
 	case serveSubCommand:
 		serve(program)
+	case removeSubCommand:
+		remove(program)
+	case watchSubCommand:
+		watch(program)
 	case helpSubCommand:
 		usage(program)
 	default:
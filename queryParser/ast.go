@@ -0,0 +1,135 @@
+package queryParser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Node of the syntax tree of a query, one of *Term, *Phrase, *Near, *And, *Or, *Not or *Group
+type Node interface {
+	// Renders the node back in the query syntax
+	String() string
+}
+
+// Word, Field is empty unless the word is scoped to a field
+type Term struct {
+	Field string
+	Text  string
+}
+
+// Quoted phrase, Field is empty unless the phrase is scoped to a field
+type Phrase struct {
+	Field string
+	Text  string
+}
+
+// Word matching the words within Distance edits of it, Field is empty unless the word is scoped to a field
+type Fuzzy struct {
+	Field    string
+	Text     string
+	Distance uint
+}
+
+// Pattern of words, `*` matches any characters and `?` a single one. Field is empty unless the pattern is scoped to a
+// field
+type Wildcard struct {
+	Field   string
+	Pattern string
+}
+
+// Proximity constraint between two words or phrases (*Term or *Phrase)
+type Near struct {
+	Left     Node
+	Right    Node
+	Distance uint
+}
+
+type And struct {
+	Children []Node
+}
+
+type Or struct {
+	Children []Node
+}
+
+// Negated operand of an And
+type Not struct {
+	Child Node
+}
+
+// Juxtaposed clauses. A document matches the group when it matches all the Required clauses, none of the Excluded
+// clauses, and at least one of the Optional clauses when there are no Required clauses
+type Group struct {
+	Optional []Node
+	Required []Node
+	Excluded []Node
+}
+
+func withField(field string, text string) string {
+	if field == "" {
+		return text
+	}
+	return field + ":" + text
+}
+
+func (term *Term) String() string {
+	return withField(term.Field, term.Text)
+}
+
+func (phrase *Phrase) String() string {
+	return withField(phrase.Field, fmt.Sprintf("%q", phrase.Text))
+}
+
+func (fuzzy *Fuzzy) String() string {
+	return withField(fuzzy.Field, fmt.Sprintf("%s%s%d", fuzzy.Text, fuzzyOperator, fuzzy.Distance))
+}
+
+func (wildcard *Wildcard) String() string {
+	return withField(wildcard.Field, wildcard.Pattern)
+}
+
+func (near *Near) String() string {
+	return fmt.Sprintf("%s %s%d %s", near.Left, nearOperator, near.Distance, near.Right)
+}
+
+func join(nodes []Node, separator string, prefix string) string {
+	parts := make([]string, len(nodes))
+	for i, node := range nodes {
+		parts[i] = prefix + parenthesize(node)
+	}
+	return strings.Join(parts, separator)
+}
+
+func parenthesize(node Node) string {
+	switch node.(type) {
+	case *And, *Or, *Group:
+		return "(" + node.String() + ")"
+	default:
+	}
+	return node.String()
+}
+
+func (and *And) String() string {
+	return join(and.Children, " "+andOperator+" ", "")
+}
+
+func (or *Or) String() string {
+	return join(or.Children, " "+orOperator+" ", "")
+}
+
+func (not *Not) String() string {
+	return notOperator + " " + parenthesize(not.Child)
+}
+
+func (group *Group) String() string {
+	parts := []string{}
+	for _, clauses := range []struct {
+		nodes  []Node
+		prefix string
+	}{{group.Optional, ""}, {group.Required, "+"}, {group.Excluded, "-"}} {
+		if len(clauses.nodes) > 0 {
+			parts = append(parts, join(clauses.nodes, " ", clauses.prefix))
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,430 @@
+package queryParser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	andOperator  = "AND"
+	orOperator   = "OR"
+	notOperator  = "NOT"
+	nearOperator = "NEAR/"
+	// Suffix of the fuzzy words, followed by their maximum edit distance
+	fuzzyOperator = "~"
+	// Wildcards of the patterns, matching any runes and a single rune
+	anyRunesWildcard = "*"
+	anyRuneWildcard  = "?"
+)
+
+// Largest edit distance of the fuzzy words, also the distance of the fuzzy words without one (raft~)
+const maxFuzzyDistance = 2
+
+// Error occurred while parsing the query, Position is the offset (in runes) of the offending part of the query
+type ParseError struct {
+	Position int
+	Message  string
+}
+
+func (err *ParseError) Error() string {
+	return fmt.Sprintf("queryParser.Parse: %s at position %d", err.Message, err.Position)
+}
+
+type lexemeKind int
+
+const (
+	wordLexeme lexemeKind = iota
+	phraseLexeme
+	fuzzyLexeme
+	wildcardLexeme
+	leftParenLexeme
+	rightParenLexeme
+	andLexeme
+	orLexeme
+	notLexeme
+	nearLexeme
+	requiredLexeme
+	excludedLexeme
+	endLexeme
+)
+
+type lexeme struct {
+	kind     lexemeKind
+	text     string
+	position int
+	// Distance of NEAR/k, or the edit distance of the fuzzy words
+	distance uint
+	// Field the word or the phrase is scoped to, empty when unscoped
+	field string
+}
+
+func isWordBoundary(r rune) bool {
+	return unicode.IsSpace(r) || r == '(' || r == ')' || r == '"'
+}
+
+// Returns the text of the phrase starting at the quote along with the position following the closing quote
+func lexPhrase(content []rune, quote int) (string, int, error) {
+	end := quote + 1
+	for end < len(content) && content[end] != '"' {
+		end++
+	}
+	if end == len(content) {
+		return "", 0, &ParseError{Position: quote, Message: "unterminated phrase"}
+	}
+	return string(content[quote+1 : end]), end + 1, nil
+}
+
+// Splits `field:value` into its parts when field is one of the fields
+func splitField(word string, fields []string) (string, string, bool) {
+	field, value, ok := strings.Cut(word, ":")
+	if !ok {
+		return "", "", false
+	}
+	for _, candidate := range fields {
+		if field == candidate {
+			return field, value, true
+		}
+	}
+	return "", "", false
+}
+
+// Turns the word lexeme into a wildcard one when it has wildcards. The question marks ending a word without other
+// wildcards are punctuation (what is raft?)
+func lexWildcard(current lexeme) lexeme {
+	if strings.Contains(strings.TrimRight(current.text, anyRuneWildcard), anyRuneWildcard) ||
+		strings.Contains(current.text, anyRunesWildcard) {
+		current.kind = wildcardLexeme
+	}
+	return current
+}
+
+// Turns the word lexeme into a fuzzy one when it ends with `~` optionally followed by the edit distance (raft~1)
+func lexFuzzy(current lexeme) (lexeme, error) {
+	i := strings.LastIndex(current.text, fuzzyOperator)
+	if i <= 0 {
+		return current, nil
+	}
+	distance := uint64(maxFuzzyDistance)
+	if suffix := current.text[i+len(fuzzyOperator):]; suffix != "" {
+		var err error
+		if distance, err = strconv.ParseUint(suffix, 10, 0); err != nil {
+			// the tildes within the words (foo~bar) are not fuzzy operators
+			return current, nil
+		}
+	}
+	if distance > maxFuzzyDistance {
+		return current, &ParseError{Position: current.position, Message: fmt.Sprintf("invalid edit distance in `%s`, expected at most %s%d", current.text, fuzzyOperator, maxFuzzyDistance)}
+	}
+	current.kind, current.text, current.distance = fuzzyLexeme, current.text[:i], uint(distance)
+	return current, nil
+}
+
+func lex(queryString string, fields []string) ([]lexeme, error) {
+	ret := []lexeme{}
+	content := []rune(queryString)
+	position := 0
+	for position < len(content) {
+		current := content[position]
+		switch {
+		case unicode.IsSpace(current):
+			position++
+		case current == '(':
+			ret = append(ret, lexeme{kind: leftParenLexeme, text: "(", position: position})
+			position++
+		case current == ')':
+			ret = append(ret, lexeme{kind: rightParenLexeme, text: ")", position: position})
+			position++
+		case current == '"':
+			text, end, err := lexPhrase(content, position)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, lexeme{kind: phraseLexeme, text: text, position: position})
+			position = end
+		case (current == '+' || current == '-') && position+1 < len(content) && !unicode.IsSpace(content[position+1]) && content[position+1] != ')':
+			kind := requiredLexeme
+			if current == '-' {
+				kind = excludedLexeme
+			}
+			ret = append(ret, lexeme{kind: kind, text: string(current), position: position})
+			position++
+		default:
+			end := position
+			for end < len(content) && !isWordBoundary(content[end]) {
+				end++
+			}
+			word := string(content[position:end])
+			current := lexeme{kind: wordLexeme, text: word, position: position}
+			if field, value, ok := splitField(word, fields); ok {
+				current.field, current.text = field, value
+				var err error
+				if value == "" {
+					if end == len(content) || content[end] != '"' {
+						return nil, &ParseError{Position: position, Message: fmt.Sprintf("`%s` is missing its word or phrase", word)}
+					}
+					text, phraseEnd, err := lexPhrase(content, end)
+					if err != nil {
+						return nil, err
+					}
+					current.kind, current.text, end = phraseLexeme, text, phraseEnd
+				} else if current = lexWildcard(current); current.kind != wildcardLexeme {
+					if current, err = lexFuzzy(current); err != nil {
+						return nil, err
+					}
+				}
+				ret = append(ret, current)
+				position = end
+				continue
+			}
+			switch {
+			case word == andOperator:
+				current.kind = andLexeme
+			case word == orOperator:
+				current.kind = orLexeme
+			case word == notOperator:
+				current.kind = notLexeme
+			case strings.HasPrefix(word, nearOperator):
+				distance, err := strconv.ParseUint(strings.TrimPrefix(word, nearOperator), 10, 0)
+				if err != nil {
+					return nil, &ParseError{Position: position, Message: fmt.Sprintf("invalid distance in `%s`, expected %sk where k is a non negative integer", word, nearOperator)}
+				}
+				current.kind = nearLexeme
+				current.distance = uint(distance)
+			default:
+				var err error
+				if current = lexWildcard(current); current.kind != wildcardLexeme {
+					if current, err = lexFuzzy(current); err != nil {
+						return nil, err
+					}
+				}
+			}
+			ret = append(ret, current)
+			position = end
+		}
+	}
+	ret = append(ret, lexeme{kind: endLexeme, text: "end of query", position: len(content)})
+	return ret, nil
+}
+
+type parser struct {
+	lexemes []lexeme
+	current int
+}
+
+func (p *parser) peek() lexeme {
+	return p.lexemes[p.current]
+}
+
+func (p *parser) next() lexeme {
+	ret := p.lexemes[p.current]
+	if ret.kind != endLexeme {
+		p.current++
+	}
+	return ret
+}
+
+func (p *parser) errorf(at lexeme, format string, args ...any) error {
+	return &ParseError{Position: at.position, Message: fmt.Sprintf(format, args...)}
+}
+
+// sequence := clause+, clause := ("+" | "-")? or
+func (p *parser) parseSequence(start lexeme) (Node, error) {
+	group := &Group{}
+	for {
+		current := p.peek()
+		if current.kind == endLexeme || current.kind == rightParenLexeme {
+			break
+		}
+		modifier := current.kind
+		if modifier == requiredLexeme || modifier == excludedLexeme {
+			p.next()
+		}
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		not, negated := node.(*Not)
+		switch {
+		case negated && modifier == requiredLexeme:
+			return nil, p.errorf(current, "a negated clause cannot be required")
+		case negated && modifier == excludedLexeme:
+			return nil, p.errorf(current, "a negated clause cannot be excluded")
+		case negated:
+			group.Excluded = append(group.Excluded, not.Child)
+		case modifier == requiredLexeme:
+			group.Required = append(group.Required, node)
+		case modifier == excludedLexeme:
+			group.Excluded = append(group.Excluded, node)
+		default:
+			group.Optional = append(group.Optional, node)
+		}
+	}
+	if len(group.Optional)+len(group.Required) == 0 {
+		return nil, p.errorf(start, "expected at least one term which is not excluded")
+	}
+	if len(group.Optional) == 1 && len(group.Required)+len(group.Excluded) == 0 {
+		return group.Optional[0], nil
+	}
+	return group, nil
+}
+
+// or := and ("OR" and)*
+func (p *parser) parseOr() (Node, error) {
+	start := p.peek()
+	node, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != orLexeme {
+		return node, nil
+	}
+	or := &Or{Children: []Node{node}}
+	for p.peek().kind == orLexeme {
+		p.next()
+		operand := p.peek()
+		node, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		or.Children = append(or.Children, node)
+		if _, negated := node.(*Not); negated {
+			return nil, p.errorf(operand, "NOT cannot be used as an operand of OR")
+		}
+	}
+	if _, negated := or.Children[0].(*Not); negated {
+		return nil, p.errorf(start, "NOT cannot be used as an operand of OR")
+	}
+	return or, nil
+}
+
+// and := unary ("AND" unary)*
+func (p *parser) parseAnd() (Node, error) {
+	start := p.peek()
+	node, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != andLexeme {
+		return node, nil
+	}
+	and := &And{Children: []Node{node}}
+	for p.peek().kind == andLexeme {
+		p.next()
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		and.Children = append(and.Children, node)
+	}
+	for _, child := range and.Children {
+		if _, negated := child.(*Not); !negated {
+			return and, nil
+		}
+	}
+	return nil, p.errorf(start, "AND needs at least one operand which is not negated")
+}
+
+// unary := "NOT" unary | primary
+func (p *parser) parseUnary() (Node, error) {
+	if p.peek().kind != notLexeme {
+		return p.parsePrimary()
+	}
+	not := p.next()
+	node, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if _, negated := node.(*Not); negated {
+		return nil, p.errorf(not, "NOT cannot be negated")
+	}
+	return &Not{Child: node}, nil
+}
+
+// primary := atom ("NEAR/k" atom)?
+func (p *parser) parsePrimary() (Node, error) {
+	left := p.peek()
+	node, err := p.parseAtom()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != nearLexeme {
+		return node, nil
+	}
+	near := p.next()
+	if left.kind != wordLexeme && left.kind != phraseLexeme {
+		return nil, p.errorf(left, "operands of `%s` must be words or phrases", near.text)
+	}
+	right := p.peek()
+	if right.kind != wordLexeme && right.kind != phraseLexeme {
+		return nil, p.errorf(right, "`%s` is missing its right operand, expected a word or a phrase but found `%s`", near.text, right.text)
+	}
+	rightNode, err := p.parseAtom()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind == nearLexeme {
+		return nil, p.errorf(p.peek(), "`%s` cannot be chained", p.peek().text)
+	}
+	return &Near{Left: node, Right: rightNode, Distance: near.distance}, nil
+}
+
+// atom := WORD | PHRASE | "(" sequence ")"
+func (p *parser) parseAtom() (Node, error) {
+	current := p.next()
+	switch current.kind {
+	case wordLexeme:
+		return &Term{Field: current.field, Text: current.text}, nil
+	case phraseLexeme:
+		return &Phrase{Field: current.field, Text: current.text}, nil
+	case fuzzyLexeme:
+		return &Fuzzy{Field: current.field, Text: current.text, Distance: current.distance}, nil
+	case wildcardLexeme:
+		return &Wildcard{Field: current.field, Pattern: current.text}, nil
+	case leftParenLexeme:
+		node, err := p.parseSequence(current)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != rightParenLexeme {
+			return nil, p.errorf(current, "missing `)` for `(`")
+		}
+		return node, nil
+	case endLexeme:
+		return nil, p.errorf(current, "unexpected end of query, expected a word, a phrase or `(`")
+	default:
+	}
+	return nil, p.errorf(current, "unexpected `%s`, expected a word, a phrase or `(`", current.text)
+}
+
+// Parses the query string into its syntax tree. Supported syntax:
+//   - words: raft, and quoted phrases: "connection pool"
+//   - fuzzy words matching the words within an edit distance of at most 2: raft~1, leader~ (~2)
+//   - patterns of words, with `*` matching any characters and `?` a single one: auth*, *config*, co?fig
+//   - proximity constraints: raft NEAR/3 timeout
+//   - required and excluded clauses: +kubernetes -helm
+//   - boolean operators (in the increasing order of precedence): OR, AND, NOT
+//   - grouping: (postgres OR mysql) AND replication
+//   - words and phrases scoped to one of the fields: title:raft path:"design docs"
+//
+// Juxtaposed clauses are optional, i.e. a document needs to match at least one of them, unless there are required
+// clauses. Returns nil for an empty query.
+func Parse(queryString string, fields ...string) (Node, error) {
+	lexemes, err := lex(queryString, fields)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{lexemes: lexemes}
+	if p.peek().kind == endLexeme {
+		return nil, nil
+	}
+	node, err := p.parseSequence(p.peek())
+	if err != nil {
+		return nil, err
+	}
+	if current := p.peek(); current.kind != endLexeme {
+		return nil, p.errorf(current, "unexpected `%s`", current.text)
+	}
+	return node, nil
+}
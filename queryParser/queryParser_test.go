@@ -0,0 +1,138 @@
+package queryParser
+
+import (
+	"errors"
+	"testing"
+)
+
+// Fields the words and the phrases of the queries of the tests can be scoped to
+var testFields = []string{"title", "path"}
+
+type parseCase struct {
+	query string
+	// Syntax tree of the query rendered by Node.String
+	tree string
+}
+
+type parseErrorCase struct {
+	query string
+	// Position (in runes) of the offending part of the query reported by ParseError
+	position int
+}
+
+// Checks that every query parses into the expected syntax tree
+func testParses(t *testing.T, cases []parseCase) {
+	t.Helper()
+	for _, c := range cases {
+		node, err := Parse(c.query, testFields...)
+		if err != nil {
+			t.Errorf("Parse(%q) failed: %s", c.query, err)
+			continue
+		}
+		if got := node.String(); got != c.tree {
+			t.Errorf("Parse(%q) = %s, want %s", c.query, got, c.tree)
+		}
+	}
+}
+
+// Checks that every query fails to parse with a ParseError at the expected position
+func testParseErrors(t *testing.T, cases []parseErrorCase) {
+	t.Helper()
+	for _, c := range cases {
+		node, err := Parse(c.query, testFields...)
+		var parseError *ParseError
+		if !errors.As(err, &parseError) {
+			t.Errorf("Parse(%q) = %v, %v, want a ParseError at position %d", c.query, node, err, c.position)
+			continue
+		}
+		if parseError.Position != c.position {
+			t.Errorf("Parse(%q) failed at position %d, want %d: %s", c.query, parseError.Position, c.position, err)
+		}
+	}
+}
+
+var phraseParses = []parseCase{
+	{`"connection pool"`, `"connection pool"`},
+	{`title:"raft notes"`, `title:"raft notes"`},
+	{`raft NEAR/3 timeout`, `raft NEAR/3 timeout`},
+	{`"leader election" NEAR/0 raft`, `"leader election" NEAR/0 raft`},
+	{`raft NEAR/2 "connection pool"`, `raft NEAR/2 "connection pool"`},
+	{`raft NEAR/2 timeout pool`, `raft NEAR/2 timeout pool`},
+}
+
+var phraseParseErrors = []parseErrorCase{
+	{`"connection pool`, 0},
+	{`raft "connection pool`, 5},
+	{`title:`, 0},
+	{`raft NEAR/x timeout`, 5},
+	{`raft NEAR/-1 timeout`, 5},
+	{`raft NEAR/2`, 11},
+	{`NEAR/2 raft`, 0},
+	{`(raft) NEAR/2 timeout`, 0},
+	{`raft NEAR/2 (timeout)`, 12},
+	{`raft NEAR/2 timeout NEAR/3 pool`, 20},
+}
+
+func TestParsePhrases(t *testing.T) {
+	testParses(t, phraseParses)
+	testParseErrors(t, phraseParseErrors)
+}
+
+var booleanParses = []parseCase{
+	{`postgres OR mysql AND replication`, `postgres OR (mysql AND replication)`},
+	{`postgres AND mysql OR replication`, `(postgres AND mysql) OR replication`},
+	{`a AND b OR c AND d`, `(a AND b) OR (c AND d)`},
+	{`(postgres OR mysql) AND NOT replication`, `(postgres OR mysql) AND NOT replication`},
+	{`NOT helm AND kubernetes`, `NOT helm AND kubernetes`},
+	{`postgres OR mysql replication`, `(postgres OR mysql) replication`},
+	{`+kubernetes -helm chart`, `chart +kubernetes -helm`},
+	{`kubernetes NOT helm`, `kubernetes -helm`},
+	{`-(helm OR chart) kubernetes`, `kubernetes -(helm OR chart)`},
+	{`((raft))`, `raft`},
+	{`title:raft OR path:design`, `title:raft OR path:design`},
+	{`a-b c+d`, `a-b c+d`},
+}
+
+var booleanParseErrors = []parseErrorCase{
+	{`NOT helm`, 0},
+	{`-helm`, 0},
+	{`raft OR NOT helm`, 8},
+	{`NOT helm OR raft`, 0},
+	{`NOT NOT raft`, 0},
+	{`NOT helm AND NOT chart`, 0},
+	{`+NOT helm raft`, 0},
+	{`raft -NOT helm`, 5},
+	{`(raft`, 0},
+	{`raft)`, 4},
+	{`raft AND`, 8},
+	{`raft OR OR mysql`, 8},
+	{`()`, 0},
+}
+
+func TestParseBooleanOperators(t *testing.T) {
+	testParses(t, booleanParses)
+	testParseErrors(t, booleanParseErrors)
+}
+
+var fuzzyParses = []parseCase{
+	{`raft~`, `raft~2`},
+	{`raft~0`, `raft~0`},
+	{`raft~1`, `raft~1`},
+	{`raft~2`, `raft~2`},
+	{`title:raft~1`, `title:raft~1`},
+	{`raft~1 OR leader~`, `raft~1 OR leader~2`},
+	{`foo~bar`, `foo~bar`},
+	{`~1`, `~1`},
+}
+
+var fuzzyParseErrors = []parseErrorCase{
+	{`raft~3`, 0},
+	{`leader raft~10`, 7},
+	{`title:raft~3`, 0},
+	{`raft~1 NEAR/2 timeout`, 0},
+}
+
+func TestParseFuzzyWords(t *testing.T) {
+	testParses(t, fuzzyParses)
+	testParseErrors(t, fuzzyParseErrors)
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"gosen/fileContents"
+	"gosen/queryParser"
+	"gosen/slog"
+	"gosen/tfIndex"
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	maxSnippets = 3
+	// Number of words shown on either side of the matched words of a snippet
+	snippetContext = 8
+	ellipsis       = "…"
+)
+
+// Fragment of the text of a document, Highlights are the byte offsets [start, end) of the matched words in Text
+type snippet struct {
+	Text       string
+	Highlights [][2]int
+}
+
+// Renders the snippet wrapping each of the highlights by open and close, escape is applied on all the text
+func (s snippet) render(open string, close string, escape func(string) string) string {
+	sb := strings.Builder{}
+	previous := 0
+	for _, highlight := range s.Highlights {
+		sb.WriteString(escape(s.Text[previous:highlight[0]]))
+		sb.WriteString(open)
+		sb.WriteString(escape(s.Text[highlight[0]:highlight[1]]))
+		sb.WriteString(close)
+		previous = highlight[1]
+	}
+	sb.WriteString(escape(s.Text[previous:]))
+	return sb.String()
+}
+
+// Renders the snippet as html, with the highlights marked by <mark> spans
+func (s snippet) html() string {
+	return s.render("<mark>", "</mark>", html.EscapeString)
+}
+
+// Renders the snippet for the terminal, with the highlights marked by **
+func (s snippet) terminal() string {
+	return s.render("**", "**", func(text string) string { return text })
+}
+
+// Collects the tokens of the body searched by the query in the language along with the tokens of their synonyms and
+// the tokens of the index matching its fuzzy words and its patterns, skipping the excluded parts of the query
+func highlightedTokens(index tfIndex.TFIndex, node queryParser.Node, language string, tokens map[string]bool) error {
+	addWords := func(field string, text string) {
+		if field != "" && field != bodyField {
+			return
+		}
+		for _, text := range append([]string{text}, querySynonyms[language][thesaurusKey(text, language)]...) {
+			words, _ := words(text, language)
+			for _, word := range words {
+				tokens[word] = true
+			}
+		}
+	}
+	var children []queryParser.Node
+	switch node := node.(type) {
+	case *queryParser.Term:
+		addWords(node.Field, node.Text)
+	case *queryParser.Phrase:
+		addWords(node.Field, node.Text)
+	case *queryParser.Fuzzy:
+		if node.Field != "" && node.Field != bodyField {
+			return nil
+		}
+		words, _ := words(node.Text, language)
+		for _, word := range words {
+			matches, err := index.FuzzyTokens("", word, node.Distance)
+			if err != nil {
+				return err
+			}
+			tokens[word] = true
+			for match := range matches {
+				tokens[match] = true
+			}
+		}
+	case *queryParser.Wildcard:
+		if node.Field != "" && node.Field != bodyField {
+			return nil
+		}
+		matches, err := index.WildcardTokens("", textAnalyzer.Normalize(node.Pattern), maxExpansions)
+		if err != nil {
+			return err
+		}
+		for _, match := range matches {
+			tokens[match] = true
+		}
+	case *queryParser.Near:
+		children = []queryParser.Node{node.Left, node.Right}
+	case *queryParser.And:
+		children = node.Children
+	case *queryParser.Or:
+		children = node.Children
+	case *queryParser.Group:
+		children = append(append(children, node.Optional...), node.Required...)
+	default:
+		// *queryParser.Not is excluded
+	}
+	for _, child := range children {
+		if err := highlightedTokens(index, child, language, tokens); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Replaces the runs of whitespaces in the text by single spaces
+func collapseSpaces(text string) string {
+	sb := strings.Builder{}
+	inSpace := false
+	for _, r := range text {
+		if unicode.IsSpace(r) {
+			if !inSpace {
+				sb.WriteRune(' ')
+			}
+			inSpace = true
+			continue
+		}
+		sb.WriteRune(r)
+		inSpace = false
+	}
+	return sb.String()
+}
+
+// Returns up to maxSnippets snippets of the text around the words matching the tokens, in the order of the text.
+// Matched words closer than twice the snippetContext share a snippet, the snippets with the most distinct matched
+// tokens are preferred
+func mkSnippets(text string, tokens map[string]bool) []snippet {
+	words := textAnalyzer.Words(text)
+	var clusters [][]int
+	for i, word := range words {
+		if !tokens[word.Text] {
+			continue
+		}
+		last := len(clusters) - 1
+		if last >= 0 && i-clusters[last][len(clusters[last])-1] <= 2*snippetContext {
+			clusters[last] = append(clusters[last], i)
+		} else {
+			clusters = append(clusters, []int{i})
+		}
+	}
+	distinctTokens := func(cluster []int) int {
+		seen := map[string]bool{}
+		for _, i := range cluster {
+			seen[words[i].Text] = true
+		}
+		return len(seen)
+	}
+	sort.SliceStable(clusters, func(i, j int) bool {
+		di, dj := distinctTokens(clusters[i]), distinctTokens(clusters[j])
+		if di != dj {
+			return di > dj
+		}
+		return len(clusters[i]) > len(clusters[j])
+	})
+	clusters = clusters[:min(maxSnippets, len(clusters))]
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i][0] < clusters[j][0] })
+	ret := []snippet{}
+	for _, cluster := range clusters {
+		from := max(0, cluster[0]-snippetContext)
+		to := min(len(words)-1, cluster[len(cluster)-1]+snippetContext)
+		// the snippets at the edges of the text include the stopwords at the edges
+		start, end := words[from].Start, words[to].End
+		if from == 0 {
+			start = 0
+		}
+		if to == len(words)-1 {
+			end = len(text)
+		}
+		sb := strings.Builder{}
+		if from > 0 {
+			sb.WriteString(ellipsis + " ")
+		}
+		highlights := [][2]int{}
+		previous := start
+		for j, i := range cluster {
+			if j > 0 && words[i].Start <= previous {
+				// the overlapping words (i.e. the bigrams of the CJK texts) extend the previous highlight
+				sb.WriteString(text[previous:max(previous, words[i].End)])
+				highlights[len(highlights)-1][1] = sb.Len()
+				previous = max(previous, words[i].End)
+				continue
+			}
+			gap := collapseSpaces(text[previous:words[i].Start])
+			if j == 0 && from == 0 {
+				gap = strings.TrimLeftFunc(gap, unicode.IsSpace)
+			}
+			sb.WriteString(gap)
+			highlightStart := sb.Len()
+			sb.WriteString(text[words[i].Start:words[i].End])
+			highlights = append(highlights, [2]int{highlightStart, sb.Len()})
+			previous = words[i].End
+		}
+		sb.WriteString(strings.TrimRightFunc(collapseSpaces(text[previous:end]), unicode.IsSpace))
+		if to < len(words)-1 {
+			sb.WriteString(" " + ellipsis)
+		}
+		ret = append(ret, snippet{Text: sb.String(), Highlights: highlights})
+	}
+	return ret
+}
+
+// Returns the snippets of the document highlighting the tokens, nil when the document cannot be read
+func docSnippets(docId string, tokens map[string]bool) []snippet {
+	if len(tokens) == 0 {
+		return nil
+	}
+	text, err := fileContents.FromFilePath(docId)
+	if err != nil {
+		slog.Errorf("docSnippets: cannot read the document `%s`: %s", docId, err)
+		return nil
+	}
+	return mkSnippets(text, tokens)
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"gosen/queryParser"
+	"gosen/tfIndex"
+	"gosen/tokenizer"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Corrected queries are suggested when the original query matches fewer documents
+const suggestionThreshold = 3
+
+// Words of fewer runes are never corrected, they are too short to tell the typos from the other words
+const minCorrectedLength = 3
+
+// Returns the maximum edit distance of the corrections of the word of the length in runes, 1 for the short words and 2
+// for the longer ones
+func maxEditDistance(length int) uint {
+	if length <= 4 {
+		return 1
+	}
+	return 2
+}
+
+// Checks if the token is worth correcting, i.e. it is long enough and it is neither CJK nor has digits
+func isCorrectable(token string) bool {
+	if utf8.RuneCountInString(token) < minCorrectedLength {
+		return false
+	}
+	for _, r := range token {
+		if unicode.IsNumber(r) || tokenizer.IsCJK(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Checks if the token is indexed in any of the fields the unscoped words are searched in
+func isKnownToken(index tfIndex.TFIndex, token string) (bool, error) {
+	for _, field := range defaultFields {
+		docFrequency, err := index.DocFrequency(fieldToken(field, token))
+		if err != nil {
+			return false, err
+		}
+		if docFrequency > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Returns the indexed token of the body closest to the token, see tfIndex.TFIndex.FuzzyTokens, the ties are broken by
+// the higher document frequency then alphabetically. ok is false when no token is within the maximum edit distance,
+// see maxEditDistance
+func correctToken(index tfIndex.TFIndex, token string) (string, bool, error) {
+	candidates, err := index.FuzzyTokens("", token, maxEditDistance(utf8.RuneCountInString(token)))
+	if err != nil {
+		return "", false, err
+	}
+	best, bestDistance, bestFrequency := "", uint(0), uint(0)
+	for candidate, distance := range candidates {
+		docFrequency, err := index.DocFrequency(candidate)
+		if err != nil {
+			return "", false, err
+		}
+		if best == "" || distance < bestDistance || distance == bestDistance && (docFrequency > bestFrequency ||
+			docFrequency == bestFrequency && candidate < best) {
+			best, bestDistance, bestFrequency = candidate, distance, docFrequency
+		}
+	}
+	return best, best != "", nil
+}
+
+// Returns the words one edit away from the word (deletions, transpositions, and substitutions and insertions of the
+// runes of the alphabet), possibly with duplicates
+func edits(word string, alphabet string) []string {
+	var ret []string
+	runes := []rune(word)
+	for i := 0; i <= len(runes); i++ {
+		prefix, suffix := string(runes[:i]), runes[i:]
+		if len(suffix) > 0 {
+			ret = append(ret, prefix+string(suffix[1:]))
+		}
+		if len(suffix) > 1 {
+			ret = append(ret, prefix+string(suffix[1])+string(suffix[0])+string(suffix[2:]))
+		}
+		for _, r := range alphabet {
+			if len(suffix) > 0 && r != suffix[0] {
+				ret = append(ret, prefix+string(r)+string(suffix[1:]))
+			}
+			ret = append(ret, prefix+string(r)+string(suffix))
+		}
+	}
+	return ret
+}
+
+// Returns the word to write in place of the misspelled word of the query, whose token is corrected to the corrected
+// token. The tokens are stems, hence the word is preferably corrected by a single edit into a word analyzed into the
+// corrected token (electon gives election for elect), or by keeping the suffix it has beyond its token (leadrs gives
+// leaders for leader). Otherwise the corrected token itself is returned
+func correctedWord(word string, token string, corrected string, language string) string {
+	isCorrection := func(candidate string) bool {
+		tokens, _ := words(candidate, language)
+		return len(tokens) == 1 && tokens[0] == corrected
+	}
+	word = strings.ToLower(word)
+	best := ""
+	for _, candidate := range edits(word, corrected+word+"abcdefghijklmnopqrstuvwxyz") {
+		if (best == "" || candidate < best) && isCorrection(candidate) {
+			best = candidate
+		}
+	}
+	if best != "" {
+		return best
+	}
+	if suffix, ok := strings.CutPrefix(word, token); ok && suffix != "" && isCorrection(corrected+suffix) {
+		return corrected + suffix
+	}
+	return corrected
+}
+
+// Adds the corrections of the misspelled words of the node searched in the body (e.g. leadr for leader) keyed by the
+// words as written in the query. The excluded words are not corrected
+func addCorrections(index tfIndex.TFIndex, node queryParser.Node, language string, corrections map[string]string) error {
+	addWords := func(field string, text string) error {
+		if field != "" && field != bodyField {
+			return nil
+		}
+		for _, word := range textAnalyzer.WordsIn(text, language) {
+			surface := text[word.Start:word.End]
+			if _, ok := corrections[surface]; ok || !isCorrectable(word.Text) {
+				continue
+			}
+			known, err := isKnownToken(index, word.Text)
+			if err != nil {
+				return err
+			}
+			if known {
+				continue
+			}
+			corrected, ok, err := correctToken(index, word.Text)
+			if err != nil {
+				return err
+			}
+			if ok {
+				corrections[surface] = correctedWord(surface, word.Text, corrected, language)
+			}
+		}
+		return nil
+	}
+	var children []queryParser.Node
+	switch node := node.(type) {
+	case *queryParser.Term:
+		return addWords(node.Field, node.Text)
+	case *queryParser.Phrase:
+		return addWords(node.Field, node.Text)
+	case *queryParser.Near:
+		children = []queryParser.Node{node.Left, node.Right}
+	case *queryParser.And:
+		children = node.Children
+	case *queryParser.Or:
+		children = node.Children
+	case *queryParser.Group:
+		children = append(append(children, node.Optional...), node.Required...)
+	default:
+		// *queryParser.Not is excluded
+	}
+	for _, child := range children {
+		if err := addCorrections(index, child, language, corrections); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the query string with its misspelled words replaced by the closest words of the vocabulary of the index,
+// weighted by their document frequencies. Returns an empty string when no word is corrected
+func correctQuery(index tfIndex.TFIndex, queryString string, node queryParser.Node) (string, error) {
+	language := queryLanguage(node)
+	corrections := map[string]string{}
+	if err := addCorrections(index, node, language, corrections); err != nil || len(corrections) == 0 {
+		return "", err
+	}
+	sb := strings.Builder{}
+	end := 0
+	for _, word := range textAnalyzer.WordsIn(queryString, language) {
+		if corrected, ok := corrections[queryString[word.Start:word.End]]; ok && word.Start >= end {
+			sb.WriteString(queryString[end:word.Start])
+			sb.WriteString(corrected)
+			end = word.End
+		}
+	}
+	sb.WriteString(queryString[end:])
+	if sb.String() == queryString {
+		return "", nil
+	}
+	return sb.String(), nil
+}
+
+// Returns the corrected query to suggest, see correctQuery, otherwise an empty string. It is suggested when the query
+// matches fewer than suggestionThreshold documents and the corrected query matches at least as many, or when the
+// corrected query scores higher, as the misspelled words still loosely match some documents by their ngrams. The page
+// of the results of the query at the offset gives its number of matches, and its top score unless it is a later page
+func suggestQuery(index tfIndex.TFIndex, queryString string, node queryParser.Node, searchQuery tfIndex.SearchQuery, page tfIndex.ResultPage, offset uint, scorer tfIndex.Scorer) (string, error) {
+	corrected, err := correctQuery(index, queryString, node)
+	if err != nil || corrected == "" {
+		return "", err
+	}
+	correctedQuery, _, err := mkSearchQuery(corrected)
+	if err != nil {
+		// the corrections are words, they cannot break the syntax of the query, but the suggestion is optional anyway
+		return "", nil
+	}
+	correctedPage, err := index.QueryPage(correctedQuery, 0, 1, scorer, false)
+	if err != nil {
+		return "", err
+	}
+	if page.Total < suggestionThreshold && correctedPage.Total > 0 && correctedPage.Total >= page.Total {
+		return corrected, nil
+	}
+	if offset > 0 && page.Total > 0 {
+		if page, err = index.QueryPage(searchQuery, 0, 1, scorer, false); err != nil {
+			return "", err
+		}
+	}
+	if len(correctedPage.Results) == 0 || len(page.Results) > 0 && correctedPage.Results[0].Score <= page.Results[0].Score {
+		return "", nil
+	}
+	return corrected, nil
+}
@@ -0,0 +1,63 @@
+package stemmer
+
+import (
+	"fmt"
+	"gosen/stemmer/snowball"
+	"sort"
+)
+
+// Constructors of the stemmers keyed by the ISO 639-1 codes of their languages. The stemmers keep state while
+// stemming, hence a stemmer must not be shared between goroutines
+var stemmers = map[string]func() Stemmer{
+	"en":     func() Stemmer { return &snowball.EnglishStemmer{} },
+	"porter": func() Stemmer { return &snowball.PorterStemmer{} },
+	"fr":     func() Stemmer { return &snowball.FrenchStemmer{} },
+	"de":     func() Stemmer { return &snowball.GermanStemmer{} },
+	"es":     func() Stemmer { return &snowball.SpanishStemmer{} },
+	"it":     func() Stemmer { return &snowball.ItalianStemmer{} },
+	"pt":     func() Stemmer { return &snowball.PortugueseStemmer{} },
+	"nl":     func() Stemmer { return &snowball.DutchStemmer{} },
+	"ru":     func() Stemmer { return &snowball.RussianStemmer{} },
+}
+
+// Codes of the languages known by their names
+var languageCodes = map[string]string{
+	"english":    "en",
+	"french":     "fr",
+	"german":     "de",
+	"spanish":    "es",
+	"italian":    "it",
+	"portuguese": "pt",
+	"dutch":      "nl",
+	"russian":    "ru",
+}
+
+// Returns the codes of the languages having a stemmer. `porter` is the original Porter stemmer for English
+func Languages() []string {
+	ret := []string{}
+	for language := range stemmers {
+		ret = append(ret, language)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Returns the code of the language given by its code or its name
+func LanguageCode(language string) (string, error) {
+	if code, ok := languageCodes[language]; ok {
+		return code, nil
+	}
+	if _, ok := stemmers[language]; ok {
+		return language, nil
+	}
+	return "", fmt.Errorf("stemmer.LanguageCode: unknown language `%s`, supported languages: %v", language, Languages())
+}
+
+// Returns a new stemmer for the language given by its code or its name
+func New(language string) (Stemmer, error) {
+	code, err := LanguageCode(language)
+	if err != nil {
+		return nil, err
+	}
+	return stemmers[code](), nil
+}
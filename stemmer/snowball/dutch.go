@@ -0,0 +1,664 @@
+//! This file was generated automatically by the Snowball to Go compiler
+//! http://snowballstem.org/
+
+package snowball
+
+var dutch_A_0 = []*Among{
+	{Str: "", A: -1, B: 6, F: nil},
+	{Str: "\u00E1", A: 0, B: 1, F: nil},
+	{Str: "\u00E4", A: 0, B: 1, F: nil},
+	{Str: "\u00E9", A: 0, B: 2, F: nil},
+	{Str: "\u00EB", A: 0, B: 2, F: nil},
+	{Str: "\u00ED", A: 0, B: 3, F: nil},
+	{Str: "\u00EF", A: 0, B: 3, F: nil},
+	{Str: "\u00F3", A: 0, B: 4, F: nil},
+	{Str: "\u00F6", A: 0, B: 4, F: nil},
+	{Str: "\u00FA", A: 0, B: 5, F: nil},
+	{Str: "\u00FC", A: 0, B: 5, F: nil},
+}
+
+var dutch_A_1 = []*Among{
+	{Str: "", A: -1, B: 3, F: nil},
+	{Str: "I", A: 0, B: 2, F: nil},
+	{Str: "Y", A: 0, B: 1, F: nil},
+}
+
+var dutch_A_2 = []*Among{
+	{Str: "dd", A: -1, B: -1, F: nil},
+	{Str: "kk", A: -1, B: -1, F: nil},
+	{Str: "tt", A: -1, B: -1, F: nil},
+}
+
+var dutch_A_3 = []*Among{
+	{Str: "ene", A: -1, B: 2, F: nil},
+	{Str: "se", A: -1, B: 3, F: nil},
+	{Str: "en", A: -1, B: 2, F: nil},
+	{Str: "heden", A: 2, B: 1, F: nil},
+	{Str: "s", A: -1, B: 3, F: nil},
+}
+
+var dutch_A_4 = []*Among{
+	{Str: "end", A: -1, B: 1, F: nil},
+	{Str: "ig", A: -1, B: 2, F: nil},
+	{Str: "ing", A: -1, B: 1, F: nil},
+	{Str: "lijk", A: -1, B: 3, F: nil},
+	{Str: "baar", A: -1, B: 4, F: nil},
+	{Str: "bar", A: -1, B: 5, F: nil},
+}
+
+var dutch_A_5 = []*Among{
+	{Str: "aa", A: -1, B: -1, F: nil},
+	{Str: "ee", A: -1, B: -1, F: nil},
+	{Str: "oo", A: -1, B: -1, F: nil},
+	{Str: "uu", A: -1, B: -1, F: nil},
+}
+
+var dutch_G_v = []byte{17, 65, 16, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128}
+
+var dutch_G_v_I = []byte{1, 0, 0, 17, 65, 16, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128}
+
+var dutch_G_v_j = []byte{17, 67, 16, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128}
+
+type DutchStemmer struct {
+	Env
+}
+
+type DutchContext struct {
+	i_p2      int
+	i_p1      int
+	b_e_found bool
+}
+
+func dutch_r_prelude(env *DutchStemmer, ctx interface{}) bool {
+	context := ctx.(*DutchContext)
+	_ = context
+	var among_var int32
+	var v_1 = env.Cursor
+replab0:
+	for {
+		var v_2 = env.Cursor
+	lab1:
+		for range [2]struct{}{} {
+			env.Bra = env.Cursor
+			among_var = env.FindAmong(dutch_A_0, context)
+			if among_var == 0 {
+				break lab1
+			}
+			env.Ket = env.Cursor
+			if among_var == 0 {
+				break lab1
+			} else if among_var == 1 {
+				if !env.SliceFrom("a") {
+					return false
+				}
+			} else if among_var == 2 {
+				if !env.SliceFrom("e") {
+					return false
+				}
+			} else if among_var == 3 {
+				if !env.SliceFrom("i") {
+					return false
+				}
+			} else if among_var == 4 {
+				if !env.SliceFrom("o") {
+					return false
+				}
+			} else if among_var == 5 {
+				if !env.SliceFrom("u") {
+					return false
+				}
+			} else if among_var == 6 {
+				if env.Cursor >= env.Limit {
+					break lab1
+				}
+				env.NextChar()
+			}
+			continue replab0
+		}
+		env.Cursor = v_2
+		break replab0
+	}
+	env.Cursor = v_1
+	var v_3 = env.Cursor
+lab2:
+	for {
+		env.Bra = env.Cursor
+		if !env.EqS("y") {
+			env.Cursor = v_3
+			break lab2
+		}
+		env.Ket = env.Cursor
+		if !env.SliceFrom("Y") {
+			return false
+		}
+		break lab2
+	}
+replab3:
+	for {
+		var v_4 = env.Cursor
+	lab4:
+		for range [2]struct{}{} {
+		golab5:
+			for {
+				var v_5 = env.Cursor
+			lab6:
+				for {
+					if !env.InGrouping(dutch_G_v, 97, 232) {
+						break lab6
+					}
+					env.Bra = env.Cursor
+				lab7:
+					for {
+						var v_6 = env.Cursor
+					lab8:
+						for {
+							if !env.EqS("i") {
+								break lab8
+							}
+							env.Ket = env.Cursor
+							if !env.InGrouping(dutch_G_v, 97, 232) {
+								break lab8
+							}
+							if !env.SliceFrom("I") {
+								return false
+							}
+							break lab7
+						}
+						env.Cursor = v_6
+						if !env.EqS("y") {
+							break lab6
+						}
+						env.Ket = env.Cursor
+						if !env.SliceFrom("Y") {
+							return false
+						}
+						break lab7
+					}
+					env.Cursor = v_5
+					break golab5
+				}
+				env.Cursor = v_5
+				if env.Cursor >= env.Limit {
+					break lab4
+				}
+				env.NextChar()
+			}
+			continue replab3
+		}
+		env.Cursor = v_4
+		break replab3
+	}
+	return true
+}
+
+func dutch_r_mark_regions(env *DutchStemmer, ctx interface{}) bool {
+	context := ctx.(*DutchContext)
+	_ = context
+	context.i_p1 = env.Limit
+	context.i_p2 = env.Limit
+golab0:
+	for {
+	lab1:
+		for {
+			if !env.InGrouping(dutch_G_v, 97, 232) {
+				break lab1
+			}
+			break golab0
+		}
+		if env.Cursor >= env.Limit {
+			return false
+		}
+		env.NextChar()
+	}
+golab2:
+	for {
+	lab3:
+		for {
+			if !env.OutGrouping(dutch_G_v, 97, 232) {
+				break lab3
+			}
+			break golab2
+		}
+		if env.Cursor >= env.Limit {
+			return false
+		}
+		env.NextChar()
+	}
+	context.i_p1 = env.Cursor
+lab4:
+	for {
+		if !(context.i_p1 < 3) {
+			break lab4
+		}
+		context.i_p1 = 3
+		break lab4
+	}
+golab5:
+	for {
+	lab6:
+		for {
+			if !env.InGrouping(dutch_G_v, 97, 232) {
+				break lab6
+			}
+			break golab5
+		}
+		if env.Cursor >= env.Limit {
+			return false
+		}
+		env.NextChar()
+	}
+golab7:
+	for {
+	lab8:
+		for {
+			if !env.OutGrouping(dutch_G_v, 97, 232) {
+				break lab8
+			}
+			break golab7
+		}
+		if env.Cursor >= env.Limit {
+			return false
+		}
+		env.NextChar()
+	}
+	context.i_p2 = env.Cursor
+	return true
+}
+
+func dutch_r_postlude(env *DutchStemmer, ctx interface{}) bool {
+	context := ctx.(*DutchContext)
+	_ = context
+	var among_var int32
+replab0:
+	for {
+		var v_1 = env.Cursor
+	lab1:
+		for range [2]struct{}{} {
+			env.Bra = env.Cursor
+			among_var = env.FindAmong(dutch_A_1, context)
+			if among_var == 0 {
+				break lab1
+			}
+			env.Ket = env.Cursor
+			if among_var == 0 {
+				break lab1
+			} else if among_var == 1 {
+				if !env.SliceFrom("y") {
+					return false
+				}
+			} else if among_var == 2 {
+				if !env.SliceFrom("i") {
+					return false
+				}
+			} else if among_var == 3 {
+				if env.Cursor >= env.Limit {
+					break lab1
+				}
+				env.NextChar()
+			}
+			continue replab0
+		}
+		env.Cursor = v_1
+		break replab0
+	}
+	return true
+}
+
+func dutch_r_R1(env *DutchStemmer, ctx interface{}) bool {
+	context := ctx.(*DutchContext)
+	_ = context
+	if !(context.i_p1 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func dutch_r_R2(env *DutchStemmer, ctx interface{}) bool {
+	context := ctx.(*DutchContext)
+	_ = context
+	if !(context.i_p2 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func dutch_r_undouble(env *DutchStemmer, ctx interface{}) bool {
+	context := ctx.(*DutchContext)
+	_ = context
+	var v_1 = env.Limit - env.Cursor
+	if env.FindAmongB(dutch_A_2, context) == 0 {
+		return false
+	}
+	env.Cursor = env.Limit - v_1
+	env.Ket = env.Cursor
+	if env.Cursor <= env.LimitBackward {
+		return false
+	}
+	env.PrevChar()
+	env.Bra = env.Cursor
+	if !env.SliceDel() {
+		return false
+	}
+	return true
+}
+
+func dutch_r_e_ending(env *DutchStemmer, ctx interface{}) bool {
+	context := ctx.(*DutchContext)
+	_ = context
+	context.b_e_found = false
+	env.Ket = env.Cursor
+	if !env.EqSB("e") {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !dutch_r_R1(env, context) {
+		return false
+	}
+	var v_1 = env.Limit - env.Cursor
+	if !env.OutGroupingB(dutch_G_v, 97, 232) {
+		return false
+	}
+	env.Cursor = env.Limit - v_1
+	if !env.SliceDel() {
+		return false
+	}
+	context.b_e_found = true
+	if !dutch_r_undouble(env, context) {
+		return false
+	}
+	return true
+}
+
+func dutch_r_en_ending(env *DutchStemmer, ctx interface{}) bool {
+	context := ctx.(*DutchContext)
+	_ = context
+	if !dutch_r_R1(env, context) {
+		return false
+	}
+	var v_1 = env.Limit - env.Cursor
+	if !env.OutGroupingB(dutch_G_v, 97, 232) {
+		return false
+	}
+	env.Cursor = env.Limit - v_1
+	var v_2 = env.Limit - env.Cursor
+lab0:
+	for {
+		if !env.EqSB("gem") {
+			break lab0
+		}
+		return false
+	}
+	env.Cursor = env.Limit - v_2
+	if !env.SliceDel() {
+		return false
+	}
+	if !dutch_r_undouble(env, context) {
+		return false
+	}
+	return true
+}
+
+func dutch_r_standard_suffix(env *DutchStemmer, ctx interface{}) bool {
+	context := ctx.(*DutchContext)
+	_ = context
+	var among_var int32
+	var v_1 = env.Limit - env.Cursor
+lab0:
+	for {
+		env.Ket = env.Cursor
+		among_var = env.FindAmongB(dutch_A_3, context)
+		if among_var == 0 {
+			break lab0
+		}
+		env.Bra = env.Cursor
+		if among_var == 0 {
+			break lab0
+		} else if among_var == 1 {
+			if !dutch_r_R1(env, context) {
+				break lab0
+			}
+			if !env.SliceFrom("heid") {
+				return false
+			}
+		} else if among_var == 2 {
+			if !dutch_r_en_ending(env, context) {
+				break lab0
+			}
+		} else if among_var == 3 {
+			if !dutch_r_R1(env, context) {
+				break lab0
+			}
+			if !env.OutGroupingB(dutch_G_v_j, 97, 232) {
+				break lab0
+			}
+			if !env.SliceDel() {
+				return false
+			}
+		}
+		break lab0
+	}
+	env.Cursor = env.Limit - v_1
+	var v_2 = env.Limit - env.Cursor
+lab1:
+	for {
+		if !dutch_r_e_ending(env, context) {
+			break lab1
+		}
+		break lab1
+	}
+	env.Cursor = env.Limit - v_2
+	var v_3 = env.Limit - env.Cursor
+lab2:
+	for {
+		env.Ket = env.Cursor
+		if !env.EqSB("heid") {
+			break lab2
+		}
+		env.Bra = env.Cursor
+		if !dutch_r_R2(env, context) {
+			break lab2
+		}
+		var v_4 = env.Limit - env.Cursor
+	lab3:
+		for {
+			if !env.EqSB("c") {
+				break lab3
+			}
+			break lab2
+		}
+		env.Cursor = env.Limit - v_4
+		if !env.SliceDel() {
+			return false
+		}
+		env.Ket = env.Cursor
+		if !env.EqSB("en") {
+			break lab2
+		}
+		env.Bra = env.Cursor
+		if !dutch_r_en_ending(env, context) {
+			break lab2
+		}
+		break lab2
+	}
+	env.Cursor = env.Limit - v_3
+	var v_5 = env.Limit - env.Cursor
+lab4:
+	for {
+		env.Ket = env.Cursor
+		among_var = env.FindAmongB(dutch_A_4, context)
+		if among_var == 0 {
+			break lab4
+		}
+		env.Bra = env.Cursor
+		if among_var == 0 {
+			break lab4
+		} else if among_var == 1 {
+			if !dutch_r_R2(env, context) {
+				break lab4
+			}
+			if !env.SliceDel() {
+				return false
+			}
+		lab5:
+			for {
+				var v_6 = env.Limit - env.Cursor
+			lab6:
+				for {
+					env.Ket = env.Cursor
+					if !env.EqSB("ig") {
+						break lab6
+					}
+					env.Bra = env.Cursor
+					if !dutch_r_R2(env, context) {
+						break lab6
+					}
+					var v_7 = env.Limit - env.Cursor
+				lab7:
+					for {
+						if !env.EqSB("e") {
+							break lab7
+						}
+						break lab6
+					}
+					env.Cursor = env.Limit - v_7
+					if !env.SliceDel() {
+						return false
+					}
+					break lab5
+				}
+				env.Cursor = env.Limit - v_6
+				if !dutch_r_undouble(env, context) {
+					break lab4
+				}
+				break lab5
+			}
+		} else if among_var == 2 {
+			if !dutch_r_R2(env, context) {
+				break lab4
+			}
+			var v_8 = env.Limit - env.Cursor
+		lab8:
+			for {
+				if !env.EqSB("e") {
+					break lab8
+				}
+				break lab4
+			}
+			env.Cursor = env.Limit - v_8
+			if !env.SliceDel() {
+				return false
+			}
+		} else if among_var == 3 {
+			if !dutch_r_R2(env, context) {
+				break lab4
+			}
+			if !env.SliceDel() {
+				return false
+			}
+			if !dutch_r_e_ending(env, context) {
+				break lab4
+			}
+		} else if among_var == 4 {
+			if !dutch_r_R2(env, context) {
+				break lab4
+			}
+			if !env.SliceDel() {
+				return false
+			}
+		} else if among_var == 5 {
+			if !dutch_r_R2(env, context) {
+				break lab4
+			}
+			if !context.b_e_found {
+				break lab4
+			}
+			if !env.SliceDel() {
+				return false
+			}
+		}
+		break lab4
+	}
+	env.Cursor = env.Limit - v_5
+	var v_9 = env.Limit - env.Cursor
+lab9:
+	for {
+		if !env.OutGroupingB(dutch_G_v_I, 73, 232) {
+			break lab9
+		}
+		var v_10 = env.Limit - env.Cursor
+		if env.FindAmongB(dutch_A_5, context) == 0 {
+			break lab9
+		}
+		if !env.OutGroupingB(dutch_G_v, 97, 232) {
+			break lab9
+		}
+		env.Cursor = env.Limit - v_10
+		env.Ket = env.Cursor
+		if env.Cursor <= env.LimitBackward {
+			break lab9
+		}
+		env.PrevChar()
+		env.Bra = env.Cursor
+		if !env.SliceDel() {
+			return false
+		}
+		break lab9
+	}
+	env.Cursor = env.Limit - v_9
+	return true
+}
+
+func dutch_stem(env *DutchStemmer) bool {
+	var context = &DutchContext{
+		i_p2:      0,
+		i_p1:      0,
+		b_e_found: false,
+	}
+	_ = context
+	var v_1 = env.Cursor
+lab0:
+	for {
+		if !dutch_r_prelude(env, context) {
+			break lab0
+		}
+		break lab0
+	}
+	env.Cursor = v_1
+	var v_2 = env.Cursor
+lab1:
+	for {
+		if !dutch_r_mark_regions(env, context) {
+			break lab1
+		}
+		break lab1
+	}
+	env.Cursor = v_2
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit
+	var v_3 = env.Limit - env.Cursor
+lab2:
+	for {
+		if !dutch_r_standard_suffix(env, context) {
+			break lab2
+		}
+		break lab2
+	}
+	env.Cursor = env.Limit - v_3
+	env.Cursor = env.LimitBackward
+	var v_4 = env.Cursor
+lab3:
+	for {
+		if !dutch_r_postlude(env, context) {
+			break lab3
+		}
+		break lab3
+	}
+	env.Cursor = v_4
+	return true
+}
+
+func (env *DutchStemmer) Stem(token string) string {
+	env.SetCurrent(token)
+	dutch_stem(env)
+	return env.Current()
+}
@@ -0,0 +1,43 @@
+package snowball
+
+import "testing"
+
+// Excerpt of the reference vocabulary of the Dutch stemmer
+var dutchStems = []stemCase{
+	{"lichaamsziek", "lichaamsziek"},
+	{"lichamelijk", "licham"},
+	{"lichamelijke", "licham"},
+	{"lichamelijkheden", "licham"},
+	{"lichamen", "licham"},
+	{"lichere", "licher"},
+	{"licht", "licht"},
+	{"lichtbeeld", "lichtbeeld"},
+	{"lichtbruin", "lichtbruin"},
+	{"lichtdoorlatende", "lichtdoorlat"},
+	{"lichte", "licht"},
+	{"lichten", "licht"},
+	{"lichtende", "lichtend"},
+	{"lichtenvoorde", "lichtenvoord"},
+	{"lichter", "lichter"},
+	{"lichtere", "lichter"},
+	{"lichters", "lichter"},
+	{"lichtgevoeligheid", "lichtgevoel"},
+	{"lichtgewicht", "lichtgewicht"},
+	{"lichtgrijs", "lichtgrijs"},
+	{"lichthoeveelheid", "lichthoevel"},
+	{"lichtintensiteit", "lichtintensiteit"},
+	{"lichtje", "lichtj"},
+	{"lichtjes", "lichtjes"},
+	{"lichtkranten", "lichtkrant"},
+	{"lichtkring", "lichtkring"},
+	{"lichtkringen", "lichtkring"},
+	{"lichtregelsystemen", "lichtregelsystem"},
+	{"lichtste", "lichtst"},
+	{"lichtsystemen", "lichtsystem"},
+	{"lichtsterkte", "lichtsterkt"},
+	{"lichtvoetig", "lichtvoet"},
+}
+
+func TestDutchStemmer(t *testing.T) {
+	testStems(t, &DutchStemmer{}, dutchStems)
+}
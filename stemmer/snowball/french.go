@@ -0,0 +1,1240 @@
+//! This file was generated automatically by the Snowball to Go compiler
+//! http://snowballstem.org/
+
+package snowball
+
+var french_A_0 = []*Among{
+	{Str: "col", A: -1, B: -1, F: nil},
+	{Str: "par", A: -1, B: -1, F: nil},
+	{Str: "tap", A: -1, B: -1, F: nil},
+}
+
+var french_A_1 = []*Among{
+	{Str: "", A: -1, B: 4, F: nil},
+	{Str: "I", A: 0, B: 1, F: nil},
+	{Str: "U", A: 0, B: 2, F: nil},
+	{Str: "Y", A: 0, B: 3, F: nil},
+}
+
+var french_A_2 = []*Among{
+	{Str: "iqU", A: -1, B: 3, F: nil},
+	{Str: "abl", A: -1, B: 3, F: nil},
+	{Str: "I\u00E8r", A: -1, B: 4, F: nil},
+	{Str: "i\u00E8r", A: -1, B: 4, F: nil},
+	{Str: "eus", A: -1, B: 2, F: nil},
+	{Str: "iv", A: -1, B: 1, F: nil},
+}
+
+var french_A_3 = []*Among{
+	{Str: "ic", A: -1, B: 2, F: nil},
+	{Str: "abil", A: -1, B: 1, F: nil},
+	{Str: "iv", A: -1, B: 3, F: nil},
+}
+
+var french_A_4 = []*Among{
+	{Str: "iqUe", A: -1, B: 1, F: nil},
+	{Str: "atrice", A: -1, B: 2, F: nil},
+	{Str: "ance", A: -1, B: 1, F: nil},
+	{Str: "ence", A: -1, B: 5, F: nil},
+	{Str: "logie", A: -1, B: 3, F: nil},
+	{Str: "able", A: -1, B: 1, F: nil},
+	{Str: "isme", A: -1, B: 1, F: nil},
+	{Str: "euse", A: -1, B: 11, F: nil},
+	{Str: "iste", A: -1, B: 1, F: nil},
+	{Str: "ive", A: -1, B: 8, F: nil},
+	{Str: "if", A: -1, B: 8, F: nil},
+	{Str: "usion", A: -1, B: 4, F: nil},
+	{Str: "ation", A: -1, B: 2, F: nil},
+	{Str: "ution", A: -1, B: 4, F: nil},
+	{Str: "ateur", A: -1, B: 2, F: nil},
+	{Str: "iqUes", A: -1, B: 1, F: nil},
+	{Str: "atrices", A: -1, B: 2, F: nil},
+	{Str: "ances", A: -1, B: 1, F: nil},
+	{Str: "ences", A: -1, B: 5, F: nil},
+	{Str: "logies", A: -1, B: 3, F: nil},
+	{Str: "ables", A: -1, B: 1, F: nil},
+	{Str: "ismes", A: -1, B: 1, F: nil},
+	{Str: "euses", A: -1, B: 11, F: nil},
+	{Str: "istes", A: -1, B: 1, F: nil},
+	{Str: "ives", A: -1, B: 8, F: nil},
+	{Str: "ifs", A: -1, B: 8, F: nil},
+	{Str: "usions", A: -1, B: 4, F: nil},
+	{Str: "ations", A: -1, B: 2, F: nil},
+	{Str: "utions", A: -1, B: 4, F: nil},
+	{Str: "ateurs", A: -1, B: 2, F: nil},
+	{Str: "ments", A: -1, B: 15, F: nil},
+	{Str: "ements", A: 30, B: 6, F: nil},
+	{Str: "issements", A: 31, B: 12, F: nil},
+	{Str: "it\u00E9s", A: -1, B: 7, F: nil},
+	{Str: "ment", A: -1, B: 15, F: nil},
+	{Str: "ement", A: 34, B: 6, F: nil},
+	{Str: "issement", A: 35, B: 12, F: nil},
+	{Str: "amment", A: 34, B: 13, F: nil},
+	{Str: "emment", A: 34, B: 14, F: nil},
+	{Str: "aux", A: -1, B: 10, F: nil},
+	{Str: "eaux", A: 39, B: 9, F: nil},
+	{Str: "eux", A: -1, B: 1, F: nil},
+	{Str: "it\u00E9", A: -1, B: 7, F: nil},
+}
+
+var french_A_5 = []*Among{
+	{Str: "ira", A: -1, B: 1, F: nil},
+	{Str: "ie", A: -1, B: 1, F: nil},
+	{Str: "isse", A: -1, B: 1, F: nil},
+	{Str: "issante", A: -1, B: 1, F: nil},
+	{Str: "i", A: -1, B: 1, F: nil},
+	{Str: "irai", A: 4, B: 1, F: nil},
+	{Str: "ir", A: -1, B: 1, F: nil},
+	{Str: "iras", A: -1, B: 1, F: nil},
+	{Str: "ies", A: -1, B: 1, F: nil},
+	{Str: "\u00EEmes", A: -1, B: 1, F: nil},
+	{Str: "isses", A: -1, B: 1, F: nil},
+	{Str: "issantes", A: -1, B: 1, F: nil},
+	{Str: "\u00EEtes", A: -1, B: 1, F: nil},
+	{Str: "is", A: -1, B: 1, F: nil},
+	{Str: "irais", A: 13, B: 1, F: nil},
+	{Str: "issais", A: 13, B: 1, F: nil},
+	{Str: "irions", A: -1, B: 1, F: nil},
+	{Str: "issions", A: -1, B: 1, F: nil},
+	{Str: "irons", A: -1, B: 1, F: nil},
+	{Str: "issons", A: -1, B: 1, F: nil},
+	{Str: "issants", A: -1, B: 1, F: nil},
+	{Str: "it", A: -1, B: 1, F: nil},
+	{Str: "irait", A: 21, B: 1, F: nil},
+	{Str: "issait", A: 21, B: 1, F: nil},
+	{Str: "issant", A: -1, B: 1, F: nil},
+	{Str: "iraIent", A: -1, B: 1, F: nil},
+	{Str: "issaIent", A: -1, B: 1, F: nil},
+	{Str: "irent", A: -1, B: 1, F: nil},
+	{Str: "issent", A: -1, B: 1, F: nil},
+	{Str: "iront", A: -1, B: 1, F: nil},
+	{Str: "\u00EEt", A: -1, B: 1, F: nil},
+	{Str: "iriez", A: -1, B: 1, F: nil},
+	{Str: "issiez", A: -1, B: 1, F: nil},
+	{Str: "irez", A: -1, B: 1, F: nil},
+	{Str: "issez", A: -1, B: 1, F: nil},
+}
+
+var french_A_6 = []*Among{
+	{Str: "a", A: -1, B: 3, F: nil},
+	{Str: "era", A: 0, B: 2, F: nil},
+	{Str: "asse", A: -1, B: 3, F: nil},
+	{Str: "ante", A: -1, B: 3, F: nil},
+	{Str: "\u00E9e", A: -1, B: 2, F: nil},
+	{Str: "ai", A: -1, B: 3, F: nil},
+	{Str: "erai", A: 5, B: 2, F: nil},
+	{Str: "er", A: -1, B: 2, F: nil},
+	{Str: "as", A: -1, B: 3, F: nil},
+	{Str: "eras", A: 8, B: 2, F: nil},
+	{Str: "\u00E2mes", A: -1, B: 3, F: nil},
+	{Str: "asses", A: -1, B: 3, F: nil},
+	{Str: "antes", A: -1, B: 3, F: nil},
+	{Str: "\u00E2tes", A: -1, B: 3, F: nil},
+	{Str: "\u00E9es", A: -1, B: 2, F: nil},
+	{Str: "ais", A: -1, B: 3, F: nil},
+	{Str: "erais", A: 15, B: 2, F: nil},
+	{Str: "ions", A: -1, B: 1, F: nil},
+	{Str: "erions", A: 17, B: 2, F: nil},
+	{Str: "assions", A: 17, B: 3, F: nil},
+	{Str: "erons", A: -1, B: 2, F: nil},
+	{Str: "ants", A: -1, B: 3, F: nil},
+	{Str: "\u00E9s", A: -1, B: 2, F: nil},
+	{Str: "ait", A: -1, B: 3, F: nil},
+	{Str: "erait", A: 23, B: 2, F: nil},
+	{Str: "ant", A: -1, B: 3, F: nil},
+	{Str: "aIent", A: -1, B: 3, F: nil},
+	{Str: "eraIent", A: 26, B: 2, F: nil},
+	{Str: "\u00E8rent", A: -1, B: 2, F: nil},
+	{Str: "assent", A: -1, B: 3, F: nil},
+	{Str: "eront", A: -1, B: 2, F: nil},
+	{Str: "\u00E2t", A: -1, B: 3, F: nil},
+	{Str: "ez", A: -1, B: 2, F: nil},
+	{Str: "iez", A: 32, B: 2, F: nil},
+	{Str: "eriez", A: 33, B: 2, F: nil},
+	{Str: "assiez", A: 33, B: 3, F: nil},
+	{Str: "erez", A: 32, B: 2, F: nil},
+	{Str: "\u00E9", A: -1, B: 2, F: nil},
+}
+
+var french_A_7 = []*Among{
+	{Str: "e", A: -1, B: 3, F: nil},
+	{Str: "I\u00E8re", A: 0, B: 2, F: nil},
+	{Str: "i\u00E8re", A: 0, B: 2, F: nil},
+	{Str: "ion", A: -1, B: 1, F: nil},
+	{Str: "Ier", A: -1, B: 2, F: nil},
+	{Str: "ier", A: -1, B: 2, F: nil},
+	{Str: "\u00EB", A: -1, B: 4, F: nil},
+}
+
+var french_A_8 = []*Among{
+	{Str: "ell", A: -1, B: -1, F: nil},
+	{Str: "eill", A: -1, B: -1, F: nil},
+	{Str: "enn", A: -1, B: -1, F: nil},
+	{Str: "onn", A: -1, B: -1, F: nil},
+	{Str: "ett", A: -1, B: -1, F: nil},
+}
+
+var french_G_v = []byte{17, 65, 16, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128, 130, 103, 8, 5}
+
+var french_G_keep_with_s = []byte{1, 65, 20, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128}
+
+type FrenchStemmer struct {
+	Env
+}
+
+type FrenchContext struct {
+	i_p2 int
+	i_p1 int
+	i_pV int
+}
+
+func french_r_prelude(env *FrenchStemmer, ctx interface{}) bool {
+	context := ctx.(*FrenchContext)
+	_ = context
+replab0:
+	for {
+		var v_1 = env.Cursor
+	lab1:
+		for range [2]struct{}{} {
+		golab2:
+			for {
+				var v_2 = env.Cursor
+			lab3:
+				for {
+				lab4:
+					for {
+						var v_3 = env.Cursor
+					lab5:
+						for {
+							if !env.InGrouping(french_G_v, 97, 251) {
+								break lab5
+							}
+							env.Bra = env.Cursor
+						lab6:
+							for {
+								var v_4 = env.Cursor
+							lab7:
+								for {
+									if !env.EqS("u") {
+										break lab7
+									}
+									env.Ket = env.Cursor
+									if !env.InGrouping(french_G_v, 97, 251) {
+										break lab7
+									}
+									if !env.SliceFrom("U") {
+										return false
+									}
+									break lab6
+								}
+								env.Cursor = v_4
+							lab8:
+								for {
+									if !env.EqS("i") {
+										break lab8
+									}
+									env.Ket = env.Cursor
+									if !env.InGrouping(french_G_v, 97, 251) {
+										break lab8
+									}
+									if !env.SliceFrom("I") {
+										return false
+									}
+									break lab6
+								}
+								env.Cursor = v_4
+								if !env.EqS("y") {
+									break lab5
+								}
+								env.Ket = env.Cursor
+								if !env.SliceFrom("Y") {
+									return false
+								}
+								break lab6
+							}
+							break lab4
+						}
+						env.Cursor = v_3
+					lab9:
+						for {
+							env.Bra = env.Cursor
+							if !env.EqS("y") {
+								break lab9
+							}
+							env.Ket = env.Cursor
+							if !env.InGrouping(french_G_v, 97, 251) {
+								break lab9
+							}
+							if !env.SliceFrom("Y") {
+								return false
+							}
+							break lab4
+						}
+						env.Cursor = v_3
+						if !env.EqS("q") {
+							break lab3
+						}
+						env.Bra = env.Cursor
+						if !env.EqS("u") {
+							break lab3
+						}
+						env.Ket = env.Cursor
+						if !env.SliceFrom("U") {
+							return false
+						}
+						break lab4
+					}
+					env.Cursor = v_2
+					break golab2
+				}
+				env.Cursor = v_2
+				if env.Cursor >= env.Limit {
+					break lab1
+				}
+				env.NextChar()
+			}
+			continue replab0
+		}
+		env.Cursor = v_1
+		break replab0
+	}
+	return true
+}
+
+func french_r_mark_regions(env *FrenchStemmer, ctx interface{}) bool {
+	context := ctx.(*FrenchContext)
+	_ = context
+	context.i_pV = env.Limit
+	context.i_p1 = env.Limit
+	context.i_p2 = env.Limit
+	var v_1 = env.Cursor
+lab0:
+	for {
+	lab1:
+		for {
+			var v_2 = env.Cursor
+		lab2:
+			for {
+				if !env.InGrouping(french_G_v, 97, 251) {
+					break lab2
+				}
+				if !env.InGrouping(french_G_v, 97, 251) {
+					break lab2
+				}
+				if env.Cursor >= env.Limit {
+					break lab2
+				}
+				env.NextChar()
+				break lab1
+			}
+			env.Cursor = v_2
+		lab3:
+			for {
+				if env.FindAmong(french_A_0, context) == 0 {
+					break lab3
+				}
+				break lab1
+			}
+			env.Cursor = v_2
+			if env.Cursor >= env.Limit {
+				break lab0
+			}
+			env.NextChar()
+		golab4:
+			for {
+			lab5:
+				for {
+					if !env.InGrouping(french_G_v, 97, 251) {
+						break lab5
+					}
+					break golab4
+				}
+				if env.Cursor >= env.Limit {
+					break lab0
+				}
+				env.NextChar()
+			}
+			break lab1
+		}
+		context.i_pV = env.Cursor
+		break lab0
+	}
+	env.Cursor = v_1
+	var v_4 = env.Cursor
+lab6:
+	for {
+	golab7:
+		for {
+		lab8:
+			for {
+				if !env.InGrouping(french_G_v, 97, 251) {
+					break lab8
+				}
+				break golab7
+			}
+			if env.Cursor >= env.Limit {
+				break lab6
+			}
+			env.NextChar()
+		}
+	golab9:
+		for {
+		lab10:
+			for {
+				if !env.OutGrouping(french_G_v, 97, 251) {
+					break lab10
+				}
+				break golab9
+			}
+			if env.Cursor >= env.Limit {
+				break lab6
+			}
+			env.NextChar()
+		}
+		context.i_p1 = env.Cursor
+	golab11:
+		for {
+		lab12:
+			for {
+				if !env.InGrouping(french_G_v, 97, 251) {
+					break lab12
+				}
+				break golab11
+			}
+			if env.Cursor >= env.Limit {
+				break lab6
+			}
+			env.NextChar()
+		}
+	golab13:
+		for {
+		lab14:
+			for {
+				if !env.OutGrouping(french_G_v, 97, 251) {
+					break lab14
+				}
+				break golab13
+			}
+			if env.Cursor >= env.Limit {
+				break lab6
+			}
+			env.NextChar()
+		}
+		context.i_p2 = env.Cursor
+		break lab6
+	}
+	env.Cursor = v_4
+	return true
+}
+
+func french_r_postlude(env *FrenchStemmer, ctx interface{}) bool {
+	context := ctx.(*FrenchContext)
+	_ = context
+	var among_var int32
+replab0:
+	for {
+		var v_1 = env.Cursor
+	lab1:
+		for range [2]struct{}{} {
+			env.Bra = env.Cursor
+			among_var = env.FindAmong(french_A_1, context)
+			if among_var == 0 {
+				break lab1
+			}
+			env.Ket = env.Cursor
+			if among_var == 0 {
+				break lab1
+			} else if among_var == 1 {
+				if !env.SliceFrom("i") {
+					return false
+				}
+			} else if among_var == 2 {
+				if !env.SliceFrom("u") {
+					return false
+				}
+			} else if among_var == 3 {
+				if !env.SliceFrom("y") {
+					return false
+				}
+			} else if among_var == 4 {
+				if env.Cursor >= env.Limit {
+					break lab1
+				}
+				env.NextChar()
+			}
+			continue replab0
+		}
+		env.Cursor = v_1
+		break replab0
+	}
+	return true
+}
+
+func french_r_RV(env *FrenchStemmer, ctx interface{}) bool {
+	context := ctx.(*FrenchContext)
+	_ = context
+	if !(context.i_pV <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func french_r_R1(env *FrenchStemmer, ctx interface{}) bool {
+	context := ctx.(*FrenchContext)
+	_ = context
+	if !(context.i_p1 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func french_r_R2(env *FrenchStemmer, ctx interface{}) bool {
+	context := ctx.(*FrenchContext)
+	_ = context
+	if !(context.i_p2 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func french_r_standard_suffix(env *FrenchStemmer, ctx interface{}) bool {
+	context := ctx.(*FrenchContext)
+	_ = context
+	var among_var int32
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(french_A_4, context)
+	if among_var == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if among_var == 0 {
+		return false
+	} else if among_var == 1 {
+		if !french_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 2 {
+		if !french_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		var v_1 = env.Limit - env.Cursor
+	lab0:
+		for {
+			env.Ket = env.Cursor
+			if !env.EqSB("ic") {
+				env.Cursor = env.Limit - v_1
+				break lab0
+			}
+			env.Bra = env.Cursor
+		lab1:
+			for {
+				var v_2 = env.Limit - env.Cursor
+			lab2:
+				for {
+					if !french_r_R2(env, context) {
+						break lab2
+					}
+					if !env.SliceDel() {
+						return false
+					}
+					break lab1
+				}
+				env.Cursor = env.Limit - v_2
+				if !env.SliceFrom("iqU") {
+					return false
+				}
+				break lab1
+			}
+			break lab0
+		}
+	} else if among_var == 3 {
+		if !french_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceFrom("log") {
+			return false
+		}
+	} else if among_var == 4 {
+		if !french_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceFrom("u") {
+			return false
+		}
+	} else if among_var == 5 {
+		if !french_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceFrom("ent") {
+			return false
+		}
+	} else if among_var == 6 {
+		if !french_r_RV(env, context) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		var v_3 = env.Limit - env.Cursor
+	lab3:
+		for {
+			env.Ket = env.Cursor
+			among_var = env.FindAmongB(french_A_2, context)
+			if among_var == 0 {
+				env.Cursor = env.Limit - v_3
+				break lab3
+			}
+			env.Bra = env.Cursor
+			if among_var == 0 {
+				env.Cursor = env.Limit - v_3
+				break lab3
+			} else if among_var == 1 {
+				if !french_r_R2(env, context) {
+					env.Cursor = env.Limit - v_3
+					break lab3
+				}
+				if !env.SliceDel() {
+					return false
+				}
+				env.Ket = env.Cursor
+				if !env.EqSB("at") {
+					env.Cursor = env.Limit - v_3
+					break lab3
+				}
+				env.Bra = env.Cursor
+				if !french_r_R2(env, context) {
+					env.Cursor = env.Limit - v_3
+					break lab3
+				}
+				if !env.SliceDel() {
+					return false
+				}
+			} else if among_var == 2 {
+			lab4:
+				for {
+					var v_4 = env.Limit - env.Cursor
+				lab5:
+					for {
+						if !french_r_R2(env, context) {
+							break lab5
+						}
+						if !env.SliceDel() {
+							return false
+						}
+						break lab4
+					}
+					env.Cursor = env.Limit - v_4
+					if !french_r_R1(env, context) {
+						env.Cursor = env.Limit - v_3
+						break lab3
+					}
+					if !env.SliceFrom("eux") {
+						return false
+					}
+					break lab4
+				}
+			} else if among_var == 3 {
+				if !french_r_R2(env, context) {
+					env.Cursor = env.Limit - v_3
+					break lab3
+				}
+				if !env.SliceDel() {
+					return false
+				}
+			} else if among_var == 4 {
+				if !french_r_RV(env, context) {
+					env.Cursor = env.Limit - v_3
+					break lab3
+				}
+				if !env.SliceFrom("i") {
+					return false
+				}
+			}
+			break lab3
+		}
+	} else if among_var == 7 {
+		if !french_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		var v_5 = env.Limit - env.Cursor
+	lab6:
+		for {
+			env.Ket = env.Cursor
+			among_var = env.FindAmongB(french_A_3, context)
+			if among_var == 0 {
+				env.Cursor = env.Limit - v_5
+				break lab6
+			}
+			env.Bra = env.Cursor
+			if among_var == 0 {
+				env.Cursor = env.Limit - v_5
+				break lab6
+			} else if among_var == 1 {
+			lab7:
+				for {
+					var v_6 = env.Limit - env.Cursor
+				lab8:
+					for {
+						if !french_r_R2(env, context) {
+							break lab8
+						}
+						if !env.SliceDel() {
+							return false
+						}
+						break lab7
+					}
+					env.Cursor = env.Limit - v_6
+					if !env.SliceFrom("abl") {
+						return false
+					}
+					break lab7
+				}
+			} else if among_var == 2 {
+			lab9:
+				for {
+					var v_7 = env.Limit - env.Cursor
+				lab10:
+					for {
+						if !french_r_R2(env, context) {
+							break lab10
+						}
+						if !env.SliceDel() {
+							return false
+						}
+						break lab9
+					}
+					env.Cursor = env.Limit - v_7
+					if !env.SliceFrom("iqU") {
+						return false
+					}
+					break lab9
+				}
+			} else if among_var == 3 {
+				if !french_r_R2(env, context) {
+					env.Cursor = env.Limit - v_5
+					break lab6
+				}
+				if !env.SliceDel() {
+					return false
+				}
+			}
+			break lab6
+		}
+	} else if among_var == 8 {
+		if !french_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		var v_8 = env.Limit - env.Cursor
+	lab11:
+		for {
+			env.Ket = env.Cursor
+			if !env.EqSB("at") {
+				env.Cursor = env.Limit - v_8
+				break lab11
+			}
+			env.Bra = env.Cursor
+			if !french_r_R2(env, context) {
+				env.Cursor = env.Limit - v_8
+				break lab11
+			}
+			if !env.SliceDel() {
+				return false
+			}
+			env.Ket = env.Cursor
+			if !env.EqSB("ic") {
+				env.Cursor = env.Limit - v_8
+				break lab11
+			}
+			env.Bra = env.Cursor
+		lab12:
+			for {
+				var v_9 = env.Limit - env.Cursor
+			lab13:
+				for {
+					if !french_r_R2(env, context) {
+						break lab13
+					}
+					if !env.SliceDel() {
+						return false
+					}
+					break lab12
+				}
+				env.Cursor = env.Limit - v_9
+				if !env.SliceFrom("iqU") {
+					return false
+				}
+				break lab12
+			}
+			break lab11
+		}
+	} else if among_var == 9 {
+		if !env.SliceFrom("eau") {
+			return false
+		}
+	} else if among_var == 10 {
+		if !french_r_R1(env, context) {
+			return false
+		}
+		if !env.SliceFrom("al") {
+			return false
+		}
+	} else if among_var == 11 {
+	lab14:
+		for {
+			var v_10 = env.Limit - env.Cursor
+		lab15:
+			for {
+				if !french_r_R2(env, context) {
+					break lab15
+				}
+				if !env.SliceDel() {
+					return false
+				}
+				break lab14
+			}
+			env.Cursor = env.Limit - v_10
+			if !french_r_R1(env, context) {
+				return false
+			}
+			if !env.SliceFrom("eux") {
+				return false
+			}
+			break lab14
+		}
+	} else if among_var == 12 {
+		if !french_r_R1(env, context) {
+			return false
+		}
+		if !env.OutGroupingB(french_G_v, 97, 251) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 13 {
+		if !french_r_RV(env, context) {
+			return false
+		}
+		if !env.SliceFrom("ant") {
+			return false
+		}
+		return false
+	} else if among_var == 14 {
+		if !french_r_RV(env, context) {
+			return false
+		}
+		if !env.SliceFrom("ent") {
+			return false
+		}
+		return false
+	} else if among_var == 15 {
+		var v_11 = env.Limit - env.Cursor
+		if !env.InGroupingB(french_G_v, 97, 251) {
+			return false
+		}
+		if !french_r_RV(env, context) {
+			return false
+		}
+		env.Cursor = env.Limit - v_11
+		if !env.SliceDel() {
+			return false
+		}
+		return false
+	}
+	return true
+}
+
+func french_r_i_verb_suffix(env *FrenchStemmer, ctx interface{}) bool {
+	context := ctx.(*FrenchContext)
+	_ = context
+	var among_var int32
+	var v_1 = env.Limit - env.Cursor
+	if env.Cursor < context.i_pV {
+		return false
+	}
+	env.Cursor = context.i_pV
+	var v_2 = env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v_1
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(french_A_5, context)
+	if among_var == 0 {
+		env.LimitBackward = v_2
+		return false
+	}
+	env.Bra = env.Cursor
+	if among_var == 0 {
+		env.LimitBackward = v_2
+		return false
+	} else if among_var == 1 {
+		if !env.OutGroupingB(french_G_v, 97, 251) {
+			env.LimitBackward = v_2
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	env.LimitBackward = v_2
+	return true
+}
+
+func french_r_verb_suffix(env *FrenchStemmer, ctx interface{}) bool {
+	context := ctx.(*FrenchContext)
+	_ = context
+	var among_var int32
+	var v_1 = env.Limit - env.Cursor
+	if env.Cursor < context.i_pV {
+		return false
+	}
+	env.Cursor = context.i_pV
+	var v_2 = env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v_1
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(french_A_6, context)
+	if among_var == 0 {
+		env.LimitBackward = v_2
+		return false
+	}
+	env.Bra = env.Cursor
+	if among_var == 0 {
+		env.LimitBackward = v_2
+		return false
+	} else if among_var == 1 {
+		if !french_r_R2(env, context) {
+			env.LimitBackward = v_2
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 2 {
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 3 {
+		if !env.SliceDel() {
+			return false
+		}
+		var v_3 = env.Limit - env.Cursor
+	lab0:
+		for {
+			env.Ket = env.Cursor
+			if !env.EqSB("e") {
+				env.Cursor = env.Limit - v_3
+				break lab0
+			}
+			env.Bra = env.Cursor
+			if !env.SliceDel() {
+				return false
+			}
+			break lab0
+		}
+	}
+	env.LimitBackward = v_2
+	return true
+}
+
+func french_r_residual_suffix(env *FrenchStemmer, ctx interface{}) bool {
+	context := ctx.(*FrenchContext)
+	_ = context
+	var among_var int32
+	var v_1 = env.Limit - env.Cursor
+lab0:
+	for {
+		env.Ket = env.Cursor
+		if !env.EqSB("s") {
+			env.Cursor = env.Limit - v_1
+			break lab0
+		}
+		env.Bra = env.Cursor
+		var v_2 = env.Limit - env.Cursor
+		if !env.OutGroupingB(french_G_keep_with_s, 97, 232) {
+			env.Cursor = env.Limit - v_1
+			break lab0
+		}
+		env.Cursor = env.Limit - v_2
+		if !env.SliceDel() {
+			return false
+		}
+		break lab0
+	}
+	var v_3 = env.Limit - env.Cursor
+	if env.Cursor < context.i_pV {
+		return false
+	}
+	env.Cursor = context.i_pV
+	var v_4 = env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v_3
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(french_A_7, context)
+	if among_var == 0 {
+		env.LimitBackward = v_4
+		return false
+	}
+	env.Bra = env.Cursor
+	if among_var == 0 {
+		env.LimitBackward = v_4
+		return false
+	} else if among_var == 1 {
+		if !french_r_R2(env, context) {
+			env.LimitBackward = v_4
+			return false
+		}
+	lab1:
+		for {
+			var v_5 = env.Limit - env.Cursor
+		lab2:
+			for {
+				if !env.EqSB("s") {
+					break lab2
+				}
+				break lab1
+			}
+			env.Cursor = env.Limit - v_5
+			if !env.EqSB("t") {
+				env.LimitBackward = v_4
+				return false
+			}
+			break lab1
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 2 {
+		if !env.SliceFrom("i") {
+			return false
+		}
+	} else if among_var == 3 {
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 4 {
+		if !env.EqSB("gu") {
+			env.LimitBackward = v_4
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	env.LimitBackward = v_4
+	return true
+}
+
+func french_r_un_double(env *FrenchStemmer, ctx interface{}) bool {
+	context := ctx.(*FrenchContext)
+	_ = context
+	var v_1 = env.Limit - env.Cursor
+	if env.FindAmongB(french_A_8, context) == 0 {
+		return false
+	}
+	env.Cursor = env.Limit - v_1
+	env.Ket = env.Cursor
+	if env.Cursor <= env.LimitBackward {
+		return false
+	}
+	env.PrevChar()
+	env.Bra = env.Cursor
+	if !env.SliceDel() {
+		return false
+	}
+	return true
+}
+
+func french_r_un_accent(env *FrenchStemmer, ctx interface{}) bool {
+	context := ctx.(*FrenchContext)
+	_ = context
+	var v_1 = 1
+replab0:
+	for {
+	lab1:
+		for range [2]struct{}{} {
+			if !env.OutGroupingB(french_G_v, 97, 251) {
+				break lab1
+			}
+			v_1--
+			continue replab0
+		}
+		break replab0
+	}
+	if v_1 > 0 {
+		return false
+	}
+	env.Ket = env.Cursor
+lab2:
+	for {
+		var v_3 = env.Limit - env.Cursor
+	lab3:
+		for {
+			if !env.EqSB("\u00E9") {
+				break lab3
+			}
+			break lab2
+		}
+		env.Cursor = env.Limit - v_3
+		if !env.EqSB("\u00E8") {
+			return false
+		}
+		break lab2
+	}
+	env.Bra = env.Cursor
+	if !env.SliceFrom("e") {
+		return false
+	}
+	return true
+}
+
+func french_stem(env *FrenchStemmer) bool {
+	var context = &FrenchContext{
+		i_p2: 0,
+		i_p1: 0,
+		i_pV: 0,
+	}
+	_ = context
+	var v_1 = env.Cursor
+lab0:
+	for {
+		if !french_r_prelude(env, context) {
+			break lab0
+		}
+		break lab0
+	}
+	env.Cursor = v_1
+	var v_2 = env.Cursor
+lab1:
+	for {
+		if !french_r_mark_regions(env, context) {
+			break lab1
+		}
+		break lab1
+	}
+	env.Cursor = v_2
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit
+	var v_3 = env.Limit - env.Cursor
+lab2:
+	for {
+	lab3:
+		for {
+			var v_4 = env.Limit - env.Cursor
+		lab4:
+			for {
+				var v_5 = env.Limit - env.Cursor
+			lab5:
+				for {
+					var v_6 = env.Limit - env.Cursor
+				lab6:
+					for {
+						if !french_r_standard_suffix(env, context) {
+							break lab6
+						}
+						break lab5
+					}
+					env.Cursor = env.Limit - v_6
+				lab7:
+					for {
+						if !french_r_i_verb_suffix(env, context) {
+							break lab7
+						}
+						break lab5
+					}
+					env.Cursor = env.Limit - v_6
+					if !french_r_verb_suffix(env, context) {
+						break lab4
+					}
+					break lab5
+				}
+				env.Cursor = env.Limit - v_5
+				var v_7 = env.Limit - env.Cursor
+			lab8:
+				for {
+					env.Ket = env.Cursor
+				lab9:
+					for {
+						var v_8 = env.Limit - env.Cursor
+					lab10:
+						for {
+							if !env.EqSB("Y") {
+								break lab10
+							}
+							env.Bra = env.Cursor
+							if !env.SliceFrom("i") {
+								return false
+							}
+							break lab9
+						}
+						env.Cursor = env.Limit - v_8
+						if !env.EqSB("\u00E7") {
+							env.Cursor = env.Limit - v_7
+							break lab8
+						}
+						env.Bra = env.Cursor
+						if !env.SliceFrom("c") {
+							return false
+						}
+						break lab9
+					}
+					break lab8
+				}
+				break lab3
+			}
+			env.Cursor = env.Limit - v_4
+			if !french_r_residual_suffix(env, context) {
+				break lab2
+			}
+			break lab3
+		}
+		break lab2
+	}
+	env.Cursor = env.Limit - v_3
+	var v_9 = env.Limit - env.Cursor
+lab11:
+	for {
+		if !french_r_un_double(env, context) {
+			break lab11
+		}
+		break lab11
+	}
+	env.Cursor = env.Limit - v_9
+	var v_10 = env.Limit - env.Cursor
+lab12:
+	for {
+		if !french_r_un_accent(env, context) {
+			break lab12
+		}
+		break lab12
+	}
+	env.Cursor = env.Limit - v_10
+	env.Cursor = env.LimitBackward
+	var v_11 = env.Cursor
+lab13:
+	for {
+		if !french_r_postlude(env, context) {
+			break lab13
+		}
+		break lab13
+	}
+	env.Cursor = v_11
+	return true
+}
+
+func (env *FrenchStemmer) Stem(token string) string {
+	env.SetCurrent(token)
+	french_stem(env)
+	return env.Current()
+}
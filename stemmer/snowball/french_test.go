@@ -0,0 +1,87 @@
+package snowball
+
+import "testing"
+
+// Excerpt of the reference vocabulary of the French stemmer
+var frenchStems = []stemCase{
+	{"continu", "continu"},
+	{"continua", "continu"},
+	{"continuait", "continu"},
+	{"continuant", "continu"},
+	{"continuation", "continu"},
+	{"continue", "continu"},
+	{"continué", "continu"},
+	{"continuel", "continuel"},
+	{"continuelle", "continuel"},
+	{"continuellement", "continuel"},
+	{"continuelles", "continuel"},
+	{"continuels", "continuel"},
+	{"continuer", "continu"},
+	{"continuez", "continu"},
+	{"continuité", "continu"},
+	{"continuons", "continuon"},
+	{"contorsions", "contors"},
+	{"contour", "contour"},
+	{"contournait", "contourn"},
+	{"contournant", "contourn"},
+	{"contourne", "contourn"},
+	{"contours", "contour"},
+	{"contractait", "contract"},
+	{"contracté", "contract"},
+	{"contractée", "contract"},
+	{"contracter", "contract"},
+	{"contractés", "contract"},
+	{"contractions", "contract"},
+	{"contradictoirement", "contradictoir"},
+	{"contradictoires", "contradictoir"},
+	{"contraindre", "contraindr"},
+	{"contraint", "contraint"},
+	{"contrainte", "contraint"},
+	{"contraintes", "contraint"},
+	{"contraire", "contrair"},
+	{"contraires", "contrair"},
+	{"contraria", "contrari"},
+	{"main", "main"},
+	{"mains", "main"},
+	{"maintenant", "mainten"},
+	{"maintenir", "mainten"},
+	{"maintenue", "maintenu"},
+	{"maintien", "maintien"},
+	{"maintint", "maintint"},
+	{"maire", "mair"},
+	{"maires", "mair"},
+	{"mairie", "mair"},
+	{"mais", "mais"},
+	{"maïs", "maï"},
+	{"maison", "maison"},
+	{"maisons", "maison"},
+	{"maistre", "maistr"},
+	{"maitre", "maitr"},
+	{"maître", "maîtr"},
+	{"maîtres", "maîtr"},
+	{"maîtresse", "maîtress"},
+	{"maîtresses", "maîtress"},
+	{"majesté", "majest"},
+	{"majestueuse", "majestu"},
+	{"majestueusement", "majestu"},
+	{"majestueux", "majestu"},
+	{"majeur", "majeur"},
+	{"majeure", "majeur"},
+	{"major", "major"},
+	{"majordome", "majordom"},
+	{"majordomes", "majordom"},
+	{"majorité", "major"},
+	{"malade", "malad"},
+	{"malades", "malad"},
+	{"maladive", "malad"},
+	{"maladroit", "maladroit"},
+	{"maladroite", "maladroit"},
+	{"maladroitement", "maladroit"},
+	{"maladroits", "maladroit"},
+	{"malaise", "malais"},
+	{"malavisée", "malavis"},
+}
+
+func TestFrenchStemmer(t *testing.T) {
+	testStems(t, &FrenchStemmer{}, frenchStems)
+}
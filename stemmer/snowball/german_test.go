@@ -0,0 +1,86 @@
+package snowball
+
+import "testing"
+
+// Excerpt of the reference vocabulary of the German stemmer
+var germanStems = []stemCase{
+	{"aufeinander", "aufeinand"},
+	{"aufeinanderfolge", "aufeinanderfolg"},
+	{"aufeinanderfolgen", "aufeinanderfolg"},
+	{"aufeinanderfolgend", "aufeinanderfolg"},
+	{"aufeinanderfolgende", "aufeinanderfolg"},
+	{"aufeinanderfolgenden", "aufeinanderfolg"},
+	{"aufeinanderfolgt", "aufeinanderfolgt"},
+	{"aufeinanderfolgten", "aufeinanderfolgt"},
+	{"aufeinanderschlügen", "aufeinanderschlug"},
+	{"aufenthalt", "aufenthalt"},
+	{"aufenthalten", "aufenthalt"},
+	{"aufenthaltes", "aufenthalt"},
+	{"auferlegen", "auferleg"},
+	{"auferlegt", "auferlegt"},
+	{"auferlegten", "auferlegt"},
+	{"auferstand", "auferstand"},
+	{"auferstanden", "auferstand"},
+	{"auferstandene", "auferstand"},
+	{"auferstehen", "aufersteh"},
+	{"aufersteht", "aufersteht"},
+	{"auferstehung", "aufersteh"},
+	{"auferstünde", "auferstund"},
+	{"auferwecken", "auferweck"},
+	{"auferweckt", "auferweckt"},
+	{"auferzogen", "auferzog"},
+	{"aufessen", "aufess"},
+	{"auffa", "auffa"},
+	{"auffallen", "auffall"},
+	{"auffallend", "auffall"},
+	{"auffallenden", "auffall"},
+	{"auffallender", "auffall"},
+	{"auffällig", "auffall"},
+	{"auffälligen", "auffall"},
+	{"auffälliges", "auffall"},
+	{"auffassen", "auffass"},
+	{"auffasst", "auffasst"},
+	{"auffaßt", "auffasst"},
+	{"auffassung", "auffass"},
+	{"auffassungsvermögen", "auffassungsvermog"},
+	{"kategorie", "kategori"},
+	{"kategorien", "kategori"},
+	{"kategorisch", "kategor"},
+	{"kategorische", "kategor"},
+	{"kategorischen", "kategor"},
+	{"kategorischer", "kategor"},
+	{"kater", "kat"},
+	{"kathedrale", "kathedral"},
+	{"kathinka", "kathinka"},
+	{"katholische", "kathol"},
+	{"katholischen", "kathol"},
+	{"katholischer", "kathol"},
+	{"kattun", "kattun"},
+	{"kattunhose", "kattunhos"},
+	{"kätzchen", "katzch"},
+	{"katze", "katz"},
+	{"katzen", "katz"},
+	{"katzenbuckel", "katzenbuckel"},
+	{"kätzin", "katzin"},
+	{"katzmann", "katzmann"},
+	{"kauen", "kau"},
+	{"kauerte", "kauert"},
+	{"kauf", "kauf"},
+	{"kaufe", "kauf"},
+	{"kaufen", "kauf"},
+	{"käufer", "kauf"},
+	{"kaufleute", "kaufleut"},
+	{"kaufladen", "kauflad"},
+	{"kaufmann", "kaufmann"},
+	{"kaufmanns", "kaufmann"},
+	{"kaufmannsstand", "kaufmannsstand"},
+	{"kaufpreis", "kaufpreis"},
+	{"kauft", "kauft"},
+	{"kaufte", "kauft"},
+	{"kaum", "kaum"},
+	{"kauz", "kauz"},
+}
+
+func TestGermanStemmer(t *testing.T) {
+	testStems(t, &GermanStemmer{}, germanStems)
+}
@@ -0,0 +1,991 @@
+//! This file was generated automatically by the Snowball to Go compiler
+//! http://snowballstem.org/
+
+package snowball
+
+var italian_A_0 = []*Among{
+	{Str: "", A: -1, B: 7, F: nil},
+	{Str: "qu", A: 0, B: 6, F: nil},
+	{Str: "\u00E1", A: 0, B: 1, F: nil},
+	{Str: "\u00E9", A: 0, B: 2, F: nil},
+	{Str: "\u00ED", A: 0, B: 3, F: nil},
+	{Str: "\u00F3", A: 0, B: 4, F: nil},
+	{Str: "\u00FA", A: 0, B: 5, F: nil},
+}
+
+var italian_A_1 = []*Among{
+	{Str: "", A: -1, B: 3, F: nil},
+	{Str: "I", A: 0, B: 1, F: nil},
+	{Str: "U", A: 0, B: 2, F: nil},
+}
+
+var italian_A_2 = []*Among{
+	{Str: "la", A: -1, B: -1, F: nil},
+	{Str: "cela", A: 0, B: -1, F: nil},
+	{Str: "gliela", A: 0, B: -1, F: nil},
+	{Str: "mela", A: 0, B: -1, F: nil},
+	{Str: "tela", A: 0, B: -1, F: nil},
+	{Str: "vela", A: 0, B: -1, F: nil},
+	{Str: "le", A: -1, B: -1, F: nil},
+	{Str: "cele", A: 6, B: -1, F: nil},
+	{Str: "gliele", A: 6, B: -1, F: nil},
+	{Str: "mele", A: 6, B: -1, F: nil},
+	{Str: "tele", A: 6, B: -1, F: nil},
+	{Str: "vele", A: 6, B: -1, F: nil},
+	{Str: "ne", A: -1, B: -1, F: nil},
+	{Str: "cene", A: 12, B: -1, F: nil},
+	{Str: "gliene", A: 12, B: -1, F: nil},
+	{Str: "mene", A: 12, B: -1, F: nil},
+	{Str: "sene", A: 12, B: -1, F: nil},
+	{Str: "tene", A: 12, B: -1, F: nil},
+	{Str: "vene", A: 12, B: -1, F: nil},
+	{Str: "ci", A: -1, B: -1, F: nil},
+	{Str: "li", A: -1, B: -1, F: nil},
+	{Str: "celi", A: 20, B: -1, F: nil},
+	{Str: "glieli", A: 20, B: -1, F: nil},
+	{Str: "meli", A: 20, B: -1, F: nil},
+	{Str: "teli", A: 20, B: -1, F: nil},
+	{Str: "veli", A: 20, B: -1, F: nil},
+	{Str: "gli", A: 20, B: -1, F: nil},
+	{Str: "mi", A: -1, B: -1, F: nil},
+	{Str: "si", A: -1, B: -1, F: nil},
+	{Str: "ti", A: -1, B: -1, F: nil},
+	{Str: "vi", A: -1, B: -1, F: nil},
+	{Str: "lo", A: -1, B: -1, F: nil},
+	{Str: "celo", A: 31, B: -1, F: nil},
+	{Str: "glielo", A: 31, B: -1, F: nil},
+	{Str: "melo", A: 31, B: -1, F: nil},
+	{Str: "telo", A: 31, B: -1, F: nil},
+	{Str: "velo", A: 31, B: -1, F: nil},
+}
+
+var italian_A_3 = []*Among{
+	{Str: "ando", A: -1, B: 1, F: nil},
+	{Str: "endo", A: -1, B: 1, F: nil},
+	{Str: "ar", A: -1, B: 2, F: nil},
+	{Str: "er", A: -1, B: 2, F: nil},
+	{Str: "ir", A: -1, B: 2, F: nil},
+}
+
+var italian_A_4 = []*Among{
+	{Str: "ic", A: -1, B: -1, F: nil},
+	{Str: "abil", A: -1, B: -1, F: nil},
+	{Str: "os", A: -1, B: -1, F: nil},
+	{Str: "iv", A: -1, B: 1, F: nil},
+}
+
+var italian_A_5 = []*Among{
+	{Str: "ic", A: -1, B: 1, F: nil},
+	{Str: "abil", A: -1, B: 1, F: nil},
+	{Str: "iv", A: -1, B: 1, F: nil},
+}
+
+var italian_A_6 = []*Among{
+	{Str: "ica", A: -1, B: 1, F: nil},
+	{Str: "logia", A: -1, B: 3, F: nil},
+	{Str: "osa", A: -1, B: 1, F: nil},
+	{Str: "ista", A: -1, B: 1, F: nil},
+	{Str: "iva", A: -1, B: 9, F: nil},
+	{Str: "anza", A: -1, B: 1, F: nil},
+	{Str: "enza", A: -1, B: 5, F: nil},
+	{Str: "ice", A: -1, B: 1, F: nil},
+	{Str: "atrice", A: 7, B: 1, F: nil},
+	{Str: "iche", A: -1, B: 1, F: nil},
+	{Str: "logie", A: -1, B: 3, F: nil},
+	{Str: "abile", A: -1, B: 1, F: nil},
+	{Str: "ibile", A: -1, B: 1, F: nil},
+	{Str: "usione", A: -1, B: 4, F: nil},
+	{Str: "azione", A: -1, B: 2, F: nil},
+	{Str: "uzione", A: -1, B: 4, F: nil},
+	{Str: "atore", A: -1, B: 2, F: nil},
+	{Str: "ose", A: -1, B: 1, F: nil},
+	{Str: "ante", A: -1, B: 1, F: nil},
+	{Str: "mente", A: -1, B: 1, F: nil},
+	{Str: "amente", A: 19, B: 7, F: nil},
+	{Str: "iste", A: -1, B: 1, F: nil},
+	{Str: "ive", A: -1, B: 9, F: nil},
+	{Str: "anze", A: -1, B: 1, F: nil},
+	{Str: "enze", A: -1, B: 5, F: nil},
+	{Str: "ici", A: -1, B: 1, F: nil},
+	{Str: "atrici", A: 25, B: 1, F: nil},
+	{Str: "ichi", A: -1, B: 1, F: nil},
+	{Str: "abili", A: -1, B: 1, F: nil},
+	{Str: "ibili", A: -1, B: 1, F: nil},
+	{Str: "ismi", A: -1, B: 1, F: nil},
+	{Str: "usioni", A: -1, B: 4, F: nil},
+	{Str: "azioni", A: -1, B: 2, F: nil},
+	{Str: "uzioni", A: -1, B: 4, F: nil},
+	{Str: "atori", A: -1, B: 2, F: nil},
+	{Str: "osi", A: -1, B: 1, F: nil},
+	{Str: "anti", A: -1, B: 1, F: nil},
+	{Str: "amenti", A: -1, B: 6, F: nil},
+	{Str: "imenti", A: -1, B: 6, F: nil},
+	{Str: "isti", A: -1, B: 1, F: nil},
+	{Str: "ivi", A: -1, B: 9, F: nil},
+	{Str: "ico", A: -1, B: 1, F: nil},
+	{Str: "ismo", A: -1, B: 1, F: nil},
+	{Str: "oso", A: -1, B: 1, F: nil},
+	{Str: "amento", A: -1, B: 6, F: nil},
+	{Str: "imento", A: -1, B: 6, F: nil},
+	{Str: "ivo", A: -1, B: 9, F: nil},
+	{Str: "it\u00E0", A: -1, B: 8, F: nil},
+	{Str: "ist\u00E0", A: -1, B: 1, F: nil},
+	{Str: "ist\u00E8", A: -1, B: 1, F: nil},
+	{Str: "ist\u00EC", A: -1, B: 1, F: nil},
+}
+
+var italian_A_7 = []*Among{
+	{Str: "isca", A: -1, B: 1, F: nil},
+	{Str: "enda", A: -1, B: 1, F: nil},
+	{Str: "ata", A: -1, B: 1, F: nil},
+	{Str: "ita", A: -1, B: 1, F: nil},
+	{Str: "uta", A: -1, B: 1, F: nil},
+	{Str: "ava", A: -1, B: 1, F: nil},
+	{Str: "eva", A: -1, B: 1, F: nil},
+	{Str: "iva", A: -1, B: 1, F: nil},
+	{Str: "erebbe", A: -1, B: 1, F: nil},
+	{Str: "irebbe", A: -1, B: 1, F: nil},
+	{Str: "isce", A: -1, B: 1, F: nil},
+	{Str: "ende", A: -1, B: 1, F: nil},
+	{Str: "are", A: -1, B: 1, F: nil},
+	{Str: "ere", A: -1, B: 1, F: nil},
+	{Str: "ire", A: -1, B: 1, F: nil},
+	{Str: "asse", A: -1, B: 1, F: nil},
+	{Str: "ate", A: -1, B: 1, F: nil},
+	{Str: "avate", A: 16, B: 1, F: nil},
+	{Str: "evate", A: 16, B: 1, F: nil},
+	{Str: "ivate", A: 16, B: 1, F: nil},
+	{Str: "ete", A: -1, B: 1, F: nil},
+	{Str: "erete", A: 20, B: 1, F: nil},
+	{Str: "irete", A: 20, B: 1, F: nil},
+	{Str: "ite", A: -1, B: 1, F: nil},
+	{Str: "ereste", A: -1, B: 1, F: nil},
+	{Str: "ireste", A: -1, B: 1, F: nil},
+	{Str: "ute", A: -1, B: 1, F: nil},
+	{Str: "erai", A: -1, B: 1, F: nil},
+	{Str: "irai", A: -1, B: 1, F: nil},
+	{Str: "isci", A: -1, B: 1, F: nil},
+	{Str: "endi", A: -1, B: 1, F: nil},
+	{Str: "erei", A: -1, B: 1, F: nil},
+	{Str: "irei", A: -1, B: 1, F: nil},
+	{Str: "assi", A: -1, B: 1, F: nil},
+	{Str: "ati", A: -1, B: 1, F: nil},
+	{Str: "iti", A: -1, B: 1, F: nil},
+	{Str: "eresti", A: -1, B: 1, F: nil},
+	{Str: "iresti", A: -1, B: 1, F: nil},
+	{Str: "uti", A: -1, B: 1, F: nil},
+	{Str: "avi", A: -1, B: 1, F: nil},
+	{Str: "evi", A: -1, B: 1, F: nil},
+	{Str: "ivi", A: -1, B: 1, F: nil},
+	{Str: "isco", A: -1, B: 1, F: nil},
+	{Str: "ando", A: -1, B: 1, F: nil},
+	{Str: "endo", A: -1, B: 1, F: nil},
+	{Str: "Yamo", A: -1, B: 1, F: nil},
+	{Str: "iamo", A: -1, B: 1, F: nil},
+	{Str: "avamo", A: -1, B: 1, F: nil},
+	{Str: "evamo", A: -1, B: 1, F: nil},
+	{Str: "ivamo", A: -1, B: 1, F: nil},
+	{Str: "eremo", A: -1, B: 1, F: nil},
+	{Str: "iremo", A: -1, B: 1, F: nil},
+	{Str: "assimo", A: -1, B: 1, F: nil},
+	{Str: "ammo", A: -1, B: 1, F: nil},
+	{Str: "emmo", A: -1, B: 1, F: nil},
+	{Str: "eremmo", A: 54, B: 1, F: nil},
+	{Str: "iremmo", A: 54, B: 1, F: nil},
+	{Str: "immo", A: -1, B: 1, F: nil},
+	{Str: "ano", A: -1, B: 1, F: nil},
+	{Str: "iscano", A: 58, B: 1, F: nil},
+	{Str: "avano", A: 58, B: 1, F: nil},
+	{Str: "evano", A: 58, B: 1, F: nil},
+	{Str: "ivano", A: 58, B: 1, F: nil},
+	{Str: "eranno", A: -1, B: 1, F: nil},
+	{Str: "iranno", A: -1, B: 1, F: nil},
+	{Str: "ono", A: -1, B: 1, F: nil},
+	{Str: "iscono", A: 65, B: 1, F: nil},
+	{Str: "arono", A: 65, B: 1, F: nil},
+	{Str: "erono", A: 65, B: 1, F: nil},
+	{Str: "irono", A: 65, B: 1, F: nil},
+	{Str: "erebbero", A: -1, B: 1, F: nil},
+	{Str: "irebbero", A: -1, B: 1, F: nil},
+	{Str: "assero", A: -1, B: 1, F: nil},
+	{Str: "essero", A: -1, B: 1, F: nil},
+	{Str: "issero", A: -1, B: 1, F: nil},
+	{Str: "ato", A: -1, B: 1, F: nil},
+	{Str: "ito", A: -1, B: 1, F: nil},
+	{Str: "uto", A: -1, B: 1, F: nil},
+	{Str: "avo", A: -1, B: 1, F: nil},
+	{Str: "evo", A: -1, B: 1, F: nil},
+	{Str: "ivo", A: -1, B: 1, F: nil},
+	{Str: "ar", A: -1, B: 1, F: nil},
+	{Str: "ir", A: -1, B: 1, F: nil},
+	{Str: "er\u00E0", A: -1, B: 1, F: nil},
+	{Str: "ir\u00E0", A: -1, B: 1, F: nil},
+	{Str: "er\u00F2", A: -1, B: 1, F: nil},
+	{Str: "ir\u00F2", A: -1, B: 1, F: nil},
+}
+
+var italian_G_v = []byte{17, 65, 16, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128, 128, 8, 2, 1}
+
+var italian_G_AEIO = []byte{17, 65, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128, 128, 8, 2}
+
+var italian_G_CG = []byte{17}
+
+type ItalianStemmer struct {
+	Env
+}
+
+type ItalianContext struct {
+	i_p2 int
+	i_p1 int
+	i_pV int
+}
+
+func italian_r_prelude(env *ItalianStemmer, ctx interface{}) bool {
+	context := ctx.(*ItalianContext)
+	_ = context
+	var among_var int32
+	var v_1 = env.Cursor
+replab0:
+	for {
+		var v_2 = env.Cursor
+	lab1:
+		for range [2]struct{}{} {
+			env.Bra = env.Cursor
+			among_var = env.FindAmong(italian_A_0, context)
+			if among_var == 0 {
+				break lab1
+			}
+			env.Ket = env.Cursor
+			if among_var == 0 {
+				break lab1
+			} else if among_var == 1 {
+				if !env.SliceFrom("\u00E0") {
+					return false
+				}
+			} else if among_var == 2 {
+				if !env.SliceFrom("\u00E8") {
+					return false
+				}
+			} else if among_var == 3 {
+				if !env.SliceFrom("\u00EC") {
+					return false
+				}
+			} else if among_var == 4 {
+				if !env.SliceFrom("\u00F2") {
+					return false
+				}
+			} else if among_var == 5 {
+				if !env.SliceFrom("\u00F9") {
+					return false
+				}
+			} else if among_var == 6 {
+				if !env.SliceFrom("qU") {
+					return false
+				}
+			} else if among_var == 7 {
+				if env.Cursor >= env.Limit {
+					break lab1
+				}
+				env.NextChar()
+			}
+			continue replab0
+		}
+		env.Cursor = v_2
+		break replab0
+	}
+	env.Cursor = v_1
+replab2:
+	for {
+		var v_3 = env.Cursor
+	lab3:
+		for range [2]struct{}{} {
+		golab4:
+			for {
+				var v_4 = env.Cursor
+			lab5:
+				for {
+					if !env.InGrouping(italian_G_v, 97, 249) {
+						break lab5
+					}
+					env.Bra = env.Cursor
+				lab6:
+					for {
+						var v_5 = env.Cursor
+					lab7:
+						for {
+							if !env.EqS("u") {
+								break lab7
+							}
+							env.Ket = env.Cursor
+							if !env.InGrouping(italian_G_v, 97, 249) {
+								break lab7
+							}
+							if !env.SliceFrom("U") {
+								return false
+							}
+							break lab6
+						}
+						env.Cursor = v_5
+						if !env.EqS("i") {
+							break lab5
+						}
+						env.Ket = env.Cursor
+						if !env.InGrouping(italian_G_v, 97, 249) {
+							break lab5
+						}
+						if !env.SliceFrom("I") {
+							return false
+						}
+						break lab6
+					}
+					env.Cursor = v_4
+					break golab4
+				}
+				env.Cursor = v_4
+				if env.Cursor >= env.Limit {
+					break lab3
+				}
+				env.NextChar()
+			}
+			continue replab2
+		}
+		env.Cursor = v_3
+		break replab2
+	}
+	return true
+}
+
+func italian_r_mark_regions(env *ItalianStemmer, ctx interface{}) bool {
+	context := ctx.(*ItalianContext)
+	_ = context
+	context.i_pV = env.Limit
+	context.i_p1 = env.Limit
+	context.i_p2 = env.Limit
+	var v_1 = env.Cursor
+lab0:
+	for {
+	lab1:
+		for {
+			var v_2 = env.Cursor
+		lab2:
+			for {
+				if !env.InGrouping(italian_G_v, 97, 249) {
+					break lab2
+				}
+			lab3:
+				for {
+					var v_3 = env.Cursor
+				lab4:
+					for {
+						if !env.OutGrouping(italian_G_v, 97, 249) {
+							break lab4
+						}
+					golab5:
+						for {
+						lab6:
+							for {
+								if !env.InGrouping(italian_G_v, 97, 249) {
+									break lab6
+								}
+								break golab5
+							}
+							if env.Cursor >= env.Limit {
+								break lab4
+							}
+							env.NextChar()
+						}
+						break lab3
+					}
+					env.Cursor = v_3
+					if !env.InGrouping(italian_G_v, 97, 249) {
+						break lab2
+					}
+				golab7:
+					for {
+					lab8:
+						for {
+							if !env.OutGrouping(italian_G_v, 97, 249) {
+								break lab8
+							}
+							break golab7
+						}
+						if env.Cursor >= env.Limit {
+							break lab2
+						}
+						env.NextChar()
+					}
+					break lab3
+				}
+				break lab1
+			}
+			env.Cursor = v_2
+			if !env.OutGrouping(italian_G_v, 97, 249) {
+				break lab0
+			}
+		lab9:
+			for {
+				var v_6 = env.Cursor
+			lab10:
+				for {
+					if !env.OutGrouping(italian_G_v, 97, 249) {
+						break lab10
+					}
+				golab11:
+					for {
+					lab12:
+						for {
+							if !env.InGrouping(italian_G_v, 97, 249) {
+								break lab12
+							}
+							break golab11
+						}
+						if env.Cursor >= env.Limit {
+							break lab10
+						}
+						env.NextChar()
+					}
+					break lab9
+				}
+				env.Cursor = v_6
+				if !env.InGrouping(italian_G_v, 97, 249) {
+					break lab0
+				}
+				if env.Cursor >= env.Limit {
+					break lab0
+				}
+				env.NextChar()
+				break lab9
+			}
+			break lab1
+		}
+		context.i_pV = env.Cursor
+		break lab0
+	}
+	env.Cursor = v_1
+	var v_8 = env.Cursor
+lab13:
+	for {
+	golab14:
+		for {
+		lab15:
+			for {
+				if !env.InGrouping(italian_G_v, 97, 249) {
+					break lab15
+				}
+				break golab14
+			}
+			if env.Cursor >= env.Limit {
+				break lab13
+			}
+			env.NextChar()
+		}
+	golab16:
+		for {
+		lab17:
+			for {
+				if !env.OutGrouping(italian_G_v, 97, 249) {
+					break lab17
+				}
+				break golab16
+			}
+			if env.Cursor >= env.Limit {
+				break lab13
+			}
+			env.NextChar()
+		}
+		context.i_p1 = env.Cursor
+	golab18:
+		for {
+		lab19:
+			for {
+				if !env.InGrouping(italian_G_v, 97, 249) {
+					break lab19
+				}
+				break golab18
+			}
+			if env.Cursor >= env.Limit {
+				break lab13
+			}
+			env.NextChar()
+		}
+	golab20:
+		for {
+		lab21:
+			for {
+				if !env.OutGrouping(italian_G_v, 97, 249) {
+					break lab21
+				}
+				break golab20
+			}
+			if env.Cursor >= env.Limit {
+				break lab13
+			}
+			env.NextChar()
+		}
+		context.i_p2 = env.Cursor
+		break lab13
+	}
+	env.Cursor = v_8
+	return true
+}
+
+func italian_r_postlude(env *ItalianStemmer, ctx interface{}) bool {
+	context := ctx.(*ItalianContext)
+	_ = context
+	var among_var int32
+replab0:
+	for {
+		var v_1 = env.Cursor
+	lab1:
+		for range [2]struct{}{} {
+			env.Bra = env.Cursor
+			among_var = env.FindAmong(italian_A_1, context)
+			if among_var == 0 {
+				break lab1
+			}
+			env.Ket = env.Cursor
+			if among_var == 0 {
+				break lab1
+			} else if among_var == 1 {
+				if !env.SliceFrom("i") {
+					return false
+				}
+			} else if among_var == 2 {
+				if !env.SliceFrom("u") {
+					return false
+				}
+			} else if among_var == 3 {
+				if env.Cursor >= env.Limit {
+					break lab1
+				}
+				env.NextChar()
+			}
+			continue replab0
+		}
+		env.Cursor = v_1
+		break replab0
+	}
+	return true
+}
+
+func italian_r_RV(env *ItalianStemmer, ctx interface{}) bool {
+	context := ctx.(*ItalianContext)
+	_ = context
+	if !(context.i_pV <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func italian_r_R1(env *ItalianStemmer, ctx interface{}) bool {
+	context := ctx.(*ItalianContext)
+	_ = context
+	if !(context.i_p1 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func italian_r_R2(env *ItalianStemmer, ctx interface{}) bool {
+	context := ctx.(*ItalianContext)
+	_ = context
+	if !(context.i_p2 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func italian_r_attached_pronoun(env *ItalianStemmer, ctx interface{}) bool {
+	context := ctx.(*ItalianContext)
+	_ = context
+	var among_var int32
+	env.Ket = env.Cursor
+	if env.FindAmongB(italian_A_2, context) == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	among_var = env.FindAmongB(italian_A_3, context)
+	if among_var == 0 {
+		return false
+	}
+	if !italian_r_RV(env, context) {
+		return false
+	}
+	if among_var == 0 {
+		return false
+	} else if among_var == 1 {
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 2 {
+		if !env.SliceFrom("e") {
+			return false
+		}
+	}
+	return true
+}
+
+func italian_r_standard_suffix(env *ItalianStemmer, ctx interface{}) bool {
+	context := ctx.(*ItalianContext)
+	_ = context
+	var among_var int32
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(italian_A_6, context)
+	if among_var == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if among_var == 0 {
+		return false
+	} else if among_var == 1 {
+		if !italian_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 2 {
+		if !italian_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		var v_1 = env.Limit - env.Cursor
+	lab0:
+		for {
+			env.Ket = env.Cursor
+			if !env.EqSB("ic") {
+				env.Cursor = env.Limit - v_1
+				break lab0
+			}
+			env.Bra = env.Cursor
+			if !italian_r_R2(env, context) {
+				env.Cursor = env.Limit - v_1
+				break lab0
+			}
+			if !env.SliceDel() {
+				return false
+			}
+			break lab0
+		}
+	} else if among_var == 3 {
+		if !italian_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceFrom("log") {
+			return false
+		}
+	} else if among_var == 4 {
+		if !italian_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceFrom("u") {
+			return false
+		}
+	} else if among_var == 5 {
+		if !italian_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceFrom("ente") {
+			return false
+		}
+	} else if among_var == 6 {
+		if !italian_r_RV(env, context) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 7 {
+		if !italian_r_R1(env, context) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		var v_2 = env.Limit - env.Cursor
+	lab1:
+		for {
+			env.Ket = env.Cursor
+			among_var = env.FindAmongB(italian_A_4, context)
+			if among_var == 0 {
+				env.Cursor = env.Limit - v_2
+				break lab1
+			}
+			env.Bra = env.Cursor
+			if !italian_r_R2(env, context) {
+				env.Cursor = env.Limit - v_2
+				break lab1
+			}
+			if !env.SliceDel() {
+				return false
+			}
+			if among_var == 0 {
+				env.Cursor = env.Limit - v_2
+				break lab1
+			} else if among_var == 1 {
+				env.Ket = env.Cursor
+				if !env.EqSB("at") {
+					env.Cursor = env.Limit - v_2
+					break lab1
+				}
+				env.Bra = env.Cursor
+				if !italian_r_R2(env, context) {
+					env.Cursor = env.Limit - v_2
+					break lab1
+				}
+				if !env.SliceDel() {
+					return false
+				}
+			}
+			break lab1
+		}
+	} else if among_var == 8 {
+		if !italian_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		var v_3 = env.Limit - env.Cursor
+	lab2:
+		for {
+			env.Ket = env.Cursor
+			among_var = env.FindAmongB(italian_A_5, context)
+			if among_var == 0 {
+				env.Cursor = env.Limit - v_3
+				break lab2
+			}
+			env.Bra = env.Cursor
+			if among_var == 0 {
+				env.Cursor = env.Limit - v_3
+				break lab2
+			} else if among_var == 1 {
+				if !italian_r_R2(env, context) {
+					env.Cursor = env.Limit - v_3
+					break lab2
+				}
+				if !env.SliceDel() {
+					return false
+				}
+			}
+			break lab2
+		}
+	} else if among_var == 9 {
+		if !italian_r_R2(env, context) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		var v_4 = env.Limit - env.Cursor
+	lab3:
+		for {
+			env.Ket = env.Cursor
+			if !env.EqSB("at") {
+				env.Cursor = env.Limit - v_4
+				break lab3
+			}
+			env.Bra = env.Cursor
+			if !italian_r_R2(env, context) {
+				env.Cursor = env.Limit - v_4
+				break lab3
+			}
+			if !env.SliceDel() {
+				return false
+			}
+			env.Ket = env.Cursor
+			if !env.EqSB("ic") {
+				env.Cursor = env.Limit - v_4
+				break lab3
+			}
+			env.Bra = env.Cursor
+			if !italian_r_R2(env, context) {
+				env.Cursor = env.Limit - v_4
+				break lab3
+			}
+			if !env.SliceDel() {
+				return false
+			}
+			break lab3
+		}
+	}
+	return true
+}
+
+func italian_r_verb_suffix(env *ItalianStemmer, ctx interface{}) bool {
+	context := ctx.(*ItalianContext)
+	_ = context
+	var among_var int32
+	var v_1 = env.Limit - env.Cursor
+	if env.Cursor < context.i_pV {
+		return false
+	}
+	env.Cursor = context.i_pV
+	var v_2 = env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v_1
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(italian_A_7, context)
+	if among_var == 0 {
+		env.LimitBackward = v_2
+		return false
+	}
+	env.Bra = env.Cursor
+	if among_var == 0 {
+		env.LimitBackward = v_2
+		return false
+	} else if among_var == 1 {
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	env.LimitBackward = v_2
+	return true
+}
+
+func italian_r_vowel_suffix(env *ItalianStemmer, ctx interface{}) bool {
+	context := ctx.(*ItalianContext)
+	_ = context
+	var v_1 = env.Limit - env.Cursor
+lab0:
+	for {
+		env.Ket = env.Cursor
+		if !env.InGroupingB(italian_G_AEIO, 97, 242) {
+			env.Cursor = env.Limit - v_1
+			break lab0
+		}
+		env.Bra = env.Cursor
+		if !italian_r_RV(env, context) {
+			env.Cursor = env.Limit - v_1
+			break lab0
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		env.Ket = env.Cursor
+		if !env.EqSB("i") {
+			env.Cursor = env.Limit - v_1
+			break lab0
+		}
+		env.Bra = env.Cursor
+		if !italian_r_RV(env, context) {
+			env.Cursor = env.Limit - v_1
+			break lab0
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		break lab0
+	}
+	var v_2 = env.Limit - env.Cursor
+lab1:
+	for {
+		env.Ket = env.Cursor
+		if !env.EqSB("h") {
+			env.Cursor = env.Limit - v_2
+			break lab1
+		}
+		env.Bra = env.Cursor
+		if !env.InGroupingB(italian_G_CG, 99, 103) {
+			env.Cursor = env.Limit - v_2
+			break lab1
+		}
+		if !italian_r_RV(env, context) {
+			env.Cursor = env.Limit - v_2
+			break lab1
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		break lab1
+	}
+	return true
+}
+
+func italian_stem(env *ItalianStemmer) bool {
+	var context = &ItalianContext{
+		i_p2: 0,
+		i_p1: 0,
+		i_pV: 0,
+	}
+	_ = context
+	var v_1 = env.Cursor
+lab0:
+	for {
+		if !italian_r_prelude(env, context) {
+			break lab0
+		}
+		break lab0
+	}
+	env.Cursor = v_1
+	var v_2 = env.Cursor
+lab1:
+	for {
+		if !italian_r_mark_regions(env, context) {
+			break lab1
+		}
+		break lab1
+	}
+	env.Cursor = v_2
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit
+	var v_3 = env.Limit - env.Cursor
+lab2:
+	for {
+		if !italian_r_attached_pronoun(env, context) {
+			break lab2
+		}
+		break lab2
+	}
+	env.Cursor = env.Limit - v_3
+	var v_4 = env.Limit - env.Cursor
+lab3:
+	for {
+	lab4:
+		for {
+			var v_5 = env.Limit - env.Cursor
+		lab5:
+			for {
+				if !italian_r_standard_suffix(env, context) {
+					break lab5
+				}
+				break lab4
+			}
+			env.Cursor = env.Limit - v_5
+			if !italian_r_verb_suffix(env, context) {
+				break lab3
+			}
+			break lab4
+		}
+		break lab3
+	}
+	env.Cursor = env.Limit - v_4
+	var v_6 = env.Limit - env.Cursor
+lab6:
+	for {
+		if !italian_r_vowel_suffix(env, context) {
+			break lab6
+		}
+		break lab6
+	}
+	env.Cursor = env.Limit - v_6
+	env.Cursor = env.LimitBackward
+	var v_7 = env.Cursor
+lab7:
+	for {
+		if !italian_r_postlude(env, context) {
+			break lab7
+		}
+		break lab7
+	}
+	env.Cursor = v_7
+	return true
+}
+
+func (env *ItalianStemmer) Stem(token string) string {
+	env.SetCurrent(token)
+	italian_stem(env)
+	return env.Current()
+}
@@ -0,0 +1,77 @@
+package snowball
+
+import "testing"
+
+// Excerpt of the reference vocabulary of the Italian stemmer
+var italianStems = []stemCase{
+	{"abbandonata", "abbandon"},
+	{"abbandonate", "abbandon"},
+	{"abbandonati", "abbandon"},
+	{"abbandonato", "abbandon"},
+	{"abbandonava", "abbandon"},
+	{"abbandonerà", "abbandon"},
+	{"abbandoneranno", "abbandon"},
+	{"abbandonerebbe", "abbandon"},
+	{"abbandono", "abband"},
+	{"abbaruffato", "abbaruff"},
+	{"abbassamento", "abbass"},
+	{"abbassando", "abbass"},
+	{"abbassandola", "abbass"},
+	{"abbassandole", "abbass"},
+	{"abbassar", "abbass"},
+	{"abbassare", "abbass"},
+	{"abbassarono", "abbass"},
+	{"abbassarsi", "abbass"},
+	{"abbassassero", "abbass"},
+	{"abbassato", "abbass"},
+	{"abbassava", "abbass"},
+	{"abbastanza", "abbast"},
+	{"abbattendo", "abbatt"},
+	{"abbattere", "abbatt"},
+	{"abbattimento", "abbatt"},
+	{"abbattuta", "abbatt"},
+	{"abbattuti", "abbatt"},
+	{"abbattuto", "abbatt"},
+	{"abbellita", "abbell"},
+	{"abbenchè", "abbenc"},
+	{"abbi", "abbi"},
+	{"abbia", "abbi"},
+	{"abbiam", "abbiam"},
+	{"abbiamo", "abbiam"},
+	{"abbiate", "abbi"},
+	{"abboccamento", "abbocc"},
+	{"abbonamento", "abbon"},
+	{"abbondanti", "abbond"},
+	{"abbondantissimo", "abbondantissim"},
+	{"pronunziare", "pronunz"},
+	{"pronunziato", "pronunz"},
+	{"pronunzio", "pronunz"},
+	{"propagamento", "propag"},
+	{"propagare", "propag"},
+	{"propaggine", "propaggin"},
+	{"propaggini", "propaggin"},
+	{"propalate", "propal"},
+	{"propensi", "propens"},
+	{"propensione", "propension"},
+	{"propinqua", "propinqu"},
+	{"propizia", "propiz"},
+	{"propizio", "propiz"},
+	{"propone", "propon"},
+	{"proponendo", "propon"},
+	{"proponeva", "propon"},
+	{"proponevano", "propon"},
+	{"propongo", "propong"},
+	{"proponimenti", "propon"},
+	{"proponimento", "propon"},
+	{"proporzionale", "proporzional"},
+	{"proporzionata", "proporzion"},
+	{"proporzionate", "proporzion"},
+	{"proporzionati", "proporzion"},
+	{"proporzionato", "proporzion"},
+	{"proporzione", "proporzion"},
+	{"proporzioni", "proporzion"},
+}
+
+func TestItalianStemmer(t *testing.T) {
+	testStems(t, &ItalianStemmer{}, italianStems)
+}
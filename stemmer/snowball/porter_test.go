@@ -0,0 +1,113 @@
+package snowball
+
+import "testing"
+
+// Excerpt of the reference vocabulary of the original Porter stemmer, along with the examples of its paper
+var porterStems = []stemCase{
+	{"consign", "consign"},
+	{"consigned", "consign"},
+	{"consigning", "consign"},
+	{"consignment", "consign"},
+	{"consist", "consist"},
+	{"consisted", "consist"},
+	{"consistency", "consist"},
+	{"consistent", "consist"},
+	{"consistently", "consist"},
+	{"consisting", "consist"},
+	{"consists", "consist"},
+	{"consolation", "consol"},
+	{"consolations", "consol"},
+	{"consolatory", "consolatori"},
+	{"console", "consol"},
+	{"consoled", "consol"},
+	{"consoles", "consol"},
+	{"consolidate", "consolid"},
+	{"consolidated", "consolid"},
+	{"consolidating", "consolid"},
+	{"consoling", "consol"},
+	{"consols", "consol"},
+	{"consonant", "conson"},
+	{"consort", "consort"},
+	{"consorted", "consort"},
+	{"consorting", "consort"},
+	{"conspicuous", "conspicu"},
+	{"conspicuously", "conspicu"},
+	{"conspiracy", "conspiraci"},
+	{"conspirator", "conspir"},
+	{"conspirators", "conspir"},
+	{"conspire", "conspir"},
+	{"conspired", "conspir"},
+	{"conspiring", "conspir"},
+	{"constable", "constabl"},
+	{"constables", "constabl"},
+	{"constance", "constanc"},
+	{"constancy", "constanc"},
+	{"constant", "constant"},
+	{"knack", "knack"},
+	{"knackeries", "knackeri"},
+	{"knacks", "knack"},
+	{"knag", "knag"},
+	{"knave", "knave"},
+	{"knaves", "knave"},
+	{"knavish", "knavish"},
+	{"kneaded", "knead"},
+	{"kneading", "knead"},
+	{"knee", "knee"},
+	{"kneel", "kneel"},
+	{"kneeled", "kneel"},
+	{"kneeling", "kneel"},
+	{"kneels", "kneel"},
+	{"knees", "knee"},
+	{"knell", "knell"},
+	{"knelt", "knelt"},
+	{"knew", "knew"},
+	{"knick", "knick"},
+	{"knif", "knif"},
+	{"knife", "knife"},
+	{"knight", "knight"},
+	{"knightly", "knightli"},
+	{"knights", "knight"},
+	{"knit", "knit"},
+	{"knits", "knit"},
+	{"knitted", "knit"},
+	{"knitting", "knit"},
+	{"knives", "knive"},
+	{"knob", "knob"},
+	{"knobs", "knob"},
+	{"knock", "knock"},
+	{"knocked", "knock"},
+	{"knocker", "knocker"},
+	{"knockers", "knocker"},
+	{"knocking", "knock"},
+	{"knocks", "knock"},
+	{"knopp", "knopp"},
+	{"knot", "knot"},
+	{"knots", "knot"},
+	{"caresses", "caress"},
+	{"ponies", "poni"},
+	{"ties", "ti"},
+	{"caress", "caress"},
+	{"cats", "cat"},
+	{"feed", "feed"},
+	{"agreed", "agre"},
+	{"plastered", "plaster"},
+	{"bled", "bled"},
+	{"motoring", "motor"},
+	{"sing", "sing"},
+	{"conflated", "conflat"},
+	{"troubled", "troubl"},
+	{"sized", "size"},
+	{"hopping", "hop"},
+	{"tanned", "tan"},
+	{"falling", "fall"},
+	{"hissing", "hiss"},
+	{"fizzed", "fizz"},
+	{"failing", "fail"},
+	{"filing", "file"},
+	{"happy", "happi"},
+	{"sky", "sky"},
+}
+
+func TestPorterStemmer(t *testing.T) {
+	testStems(t, &PorterStemmer{}, porterStems)
+}
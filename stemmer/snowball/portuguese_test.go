@@ -0,0 +1,50 @@
+package snowball
+
+import "testing"
+
+// Excerpt of the reference vocabulary of the Portuguese stemmer
+var portugueseStems = []stemCase{
+	{"quiabo", "quiab"},
+	{"quieta", "quiet"},
+	{"quietas", "quiet"},
+	{"quietinho", "quietinh"},
+	{"quieto", "quiet"},
+	{"quietos", "quiet"},
+	{"quietude", "quietud"},
+	{"quilate", "quilat"},
+	{"quilinhos", "quilinh"},
+	{"quilo", "quil"},
+	{"quilombo", "quilomb"},
+	{"quilométricas", "quilométr"},
+	{"quilométricos", "quilométr"},
+	{"quilômetro", "quilômetr"},
+	{"quilômetros", "quilômetr"},
+	{"quilos", "quil"},
+	{"química", "químic"},
+	{"químicas", "químic"},
+	{"químico", "químic"},
+	{"químicos", "químic"},
+	{"quimioterapia", "quimioterap"},
+	{"quimioterápicos", "quimioteráp"},
+	{"quimono", "quimon"},
+	{"quincas", "quinc"},
+	{"quinhão", "quinhã"},
+	{"quinhentos", "quinhent"},
+	{"quinn", "quinn"},
+	{"quino", "quin"},
+	{"quinta", "quint"},
+	{"quintal", "quintal"},
+	{"quintana", "quintan"},
+	{"quintanilha", "quintanilh"},
+	{"quintão", "quintã"},
+	{"quintino", "quintin"},
+	{"quinto", "quint"},
+	{"quintos", "quint"},
+	{"quintuplicou", "quintuplic"},
+	{"quinze", "quinz"},
+	{"quinzena", "quinzen"},
+}
+
+func TestPortugueseStemmer(t *testing.T) {
+	testStems(t, &PortugueseStemmer{}, portugueseStems)
+}
@@ -0,0 +1,629 @@
+//! This file was generated automatically by the Snowball to Go compiler
+//! http://snowballstem.org/
+
+package snowball
+
+var russian_A_0 = []*Among{
+	{Str: "\u0432\u0448\u0438\u0441\u044C", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u0432\u0448\u0438\u0441\u044C", A: 0, B: 2, F: nil},
+	{Str: "\u0438\u0432\u0448\u0438\u0441\u044C", A: 0, B: 2, F: nil},
+	{Str: "\u0432", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u0432", A: 3, B: 2, F: nil},
+	{Str: "\u0438\u0432", A: 3, B: 2, F: nil},
+	{Str: "\u0432\u0448\u0438", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u0432\u0448\u0438", A: 6, B: 2, F: nil},
+	{Str: "\u0438\u0432\u0448\u0438", A: 6, B: 2, F: nil},
+}
+
+var russian_A_1 = []*Among{
+	{Str: "\u0435\u043C\u0443", A: -1, B: 1, F: nil},
+	{Str: "\u043E\u043C\u0443", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u0445", A: -1, B: 1, F: nil},
+	{Str: "\u0438\u0445", A: -1, B: 1, F: nil},
+	{Str: "\u0443\u044E", A: -1, B: 1, F: nil},
+	{Str: "\u044E\u044E", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u044E", A: -1, B: 1, F: nil},
+	{Str: "\u043E\u044E", A: -1, B: 1, F: nil},
+	{Str: "\u044F\u044F", A: -1, B: 1, F: nil},
+	{Str: "\u0430\u044F", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u0435", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u0435", A: -1, B: 1, F: nil},
+	{Str: "\u0438\u0435", A: -1, B: 1, F: nil},
+	{Str: "\u043E\u0435", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u043C\u0438", A: -1, B: 1, F: nil},
+	{Str: "\u0438\u043C\u0438", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u0439", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u0439", A: -1, B: 1, F: nil},
+	{Str: "\u0438\u0439", A: -1, B: 1, F: nil},
+	{Str: "\u043E\u0439", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u043C", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u043C", A: -1, B: 1, F: nil},
+	{Str: "\u0438\u043C", A: -1, B: 1, F: nil},
+	{Str: "\u043E\u043C", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u0433\u043E", A: -1, B: 1, F: nil},
+	{Str: "\u043E\u0433\u043E", A: -1, B: 1, F: nil},
+}
+
+var russian_A_2 = []*Among{
+	{Str: "\u0432\u0448", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u0432\u0448", A: 0, B: 2, F: nil},
+	{Str: "\u0438\u0432\u0448", A: 0, B: 2, F: nil},
+	{Str: "\u0449", A: -1, B: 1, F: nil},
+	{Str: "\u044E\u0449", A: 3, B: 1, F: nil},
+	{Str: "\u0443\u044E\u0449", A: 4, B: 2, F: nil},
+	{Str: "\u0435\u043C", A: -1, B: 1, F: nil},
+	{Str: "\u043D\u043D", A: -1, B: 1, F: nil},
+}
+
+var russian_A_3 = []*Among{
+	{Str: "\u0441\u044C", A: -1, B: 1, F: nil},
+	{Str: "\u0441\u044F", A: -1, B: 1, F: nil},
+}
+
+var russian_A_4 = []*Among{
+	{Str: "\u044B\u0442", A: -1, B: 2, F: nil},
+	{Str: "\u044E\u0442", A: -1, B: 1, F: nil},
+	{Str: "\u0443\u044E\u0442", A: 1, B: 2, F: nil},
+	{Str: "\u044F\u0442", A: -1, B: 2, F: nil},
+	{Str: "\u0435\u0442", A: -1, B: 1, F: nil},
+	{Str: "\u0443\u0435\u0442", A: 4, B: 2, F: nil},
+	{Str: "\u0438\u0442", A: -1, B: 2, F: nil},
+	{Str: "\u043D\u044B", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u043D\u044B", A: 7, B: 2, F: nil},
+	{Str: "\u0442\u044C", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u0442\u044C", A: 9, B: 2, F: nil},
+	{Str: "\u0438\u0442\u044C", A: 9, B: 2, F: nil},
+	{Str: "\u0435\u0448\u044C", A: -1, B: 1, F: nil},
+	{Str: "\u0438\u0448\u044C", A: -1, B: 2, F: nil},
+	{Str: "\u044E", A: -1, B: 2, F: nil},
+	{Str: "\u0443\u044E", A: 14, B: 2, F: nil},
+	{Str: "\u043B\u0430", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u043B\u0430", A: 16, B: 2, F: nil},
+	{Str: "\u0438\u043B\u0430", A: 16, B: 2, F: nil},
+	{Str: "\u043D\u0430", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u043D\u0430", A: 19, B: 2, F: nil},
+	{Str: "\u0435\u0442\u0435", A: -1, B: 1, F: nil},
+	{Str: "\u0438\u0442\u0435", A: -1, B: 2, F: nil},
+	{Str: "\u0439\u0442\u0435", A: -1, B: 1, F: nil},
+	{Str: "\u0443\u0439\u0442\u0435", A: 23, B: 2, F: nil},
+	{Str: "\u0435\u0439\u0442\u0435", A: 23, B: 2, F: nil},
+	{Str: "\u043B\u0438", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u043B\u0438", A: 26, B: 2, F: nil},
+	{Str: "\u0438\u043B\u0438", A: 26, B: 2, F: nil},
+	{Str: "\u0439", A: -1, B: 1, F: nil},
+	{Str: "\u0443\u0439", A: 29, B: 2, F: nil},
+	{Str: "\u0435\u0439", A: 29, B: 2, F: nil},
+	{Str: "\u043B", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u043B", A: 32, B: 2, F: nil},
+	{Str: "\u0438\u043B", A: 32, B: 2, F: nil},
+	{Str: "\u044B\u043C", A: -1, B: 2, F: nil},
+	{Str: "\u0435\u043C", A: -1, B: 1, F: nil},
+	{Str: "\u0438\u043C", A: -1, B: 2, F: nil},
+	{Str: "\u043D", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u043D", A: 38, B: 2, F: nil},
+	{Str: "\u043B\u043E", A: -1, B: 1, F: nil},
+	{Str: "\u044B\u043B\u043E", A: 40, B: 2, F: nil},
+	{Str: "\u0438\u043B\u043E", A: 40, B: 2, F: nil},
+	{Str: "\u043D\u043E", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u043D\u043E", A: 43, B: 2, F: nil},
+	{Str: "\u043D\u043D\u043E", A: 43, B: 1, F: nil},
+}
+
+var russian_A_5 = []*Among{
+	{Str: "\u0443", A: -1, B: 1, F: nil},
+	{Str: "\u044F\u0445", A: -1, B: 1, F: nil},
+	{Str: "\u0438\u044F\u0445", A: 1, B: 1, F: nil},
+	{Str: "\u0430\u0445", A: -1, B: 1, F: nil},
+	{Str: "\u044B", A: -1, B: 1, F: nil},
+	{Str: "\u044C", A: -1, B: 1, F: nil},
+	{Str: "\u044E", A: -1, B: 1, F: nil},
+	{Str: "\u044C\u044E", A: 6, B: 1, F: nil},
+	{Str: "\u0438\u044E", A: 6, B: 1, F: nil},
+	{Str: "\u044F", A: -1, B: 1, F: nil},
+	{Str: "\u044C\u044F", A: 9, B: 1, F: nil},
+	{Str: "\u0438\u044F", A: 9, B: 1, F: nil},
+	{Str: "\u0430", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u0432", A: -1, B: 1, F: nil},
+	{Str: "\u043E\u0432", A: -1, B: 1, F: nil},
+	{Str: "\u0435", A: -1, B: 1, F: nil},
+	{Str: "\u044C\u0435", A: 15, B: 1, F: nil},
+	{Str: "\u0438\u0435", A: 15, B: 1, F: nil},
+	{Str: "\u0438", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u0438", A: 18, B: 1, F: nil},
+	{Str: "\u0438\u0438", A: 18, B: 1, F: nil},
+	{Str: "\u044F\u043C\u0438", A: 18, B: 1, F: nil},
+	{Str: "\u0438\u044F\u043C\u0438", A: 21, B: 1, F: nil},
+	{Str: "\u0430\u043C\u0438", A: 18, B: 1, F: nil},
+	{Str: "\u0439", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u0439", A: 24, B: 1, F: nil},
+	{Str: "\u0438\u0435\u0439", A: 25, B: 1, F: nil},
+	{Str: "\u0438\u0439", A: 24, B: 1, F: nil},
+	{Str: "\u043E\u0439", A: 24, B: 1, F: nil},
+	{Str: "\u044F\u043C", A: -1, B: 1, F: nil},
+	{Str: "\u0438\u044F\u043C", A: 29, B: 1, F: nil},
+	{Str: "\u0430\u043C", A: -1, B: 1, F: nil},
+	{Str: "\u0435\u043C", A: -1, B: 1, F: nil},
+	{Str: "\u0438\u0435\u043C", A: 32, B: 1, F: nil},
+	{Str: "\u043E\u043C", A: -1, B: 1, F: nil},
+	{Str: "\u043E", A: -1, B: 1, F: nil},
+}
+
+var russian_A_6 = []*Among{
+	{Str: "\u043E\u0441\u0442", A: -1, B: 1, F: nil},
+	{Str: "\u043E\u0441\u0442\u044C", A: -1, B: 1, F: nil},
+}
+
+var russian_A_7 = []*Among{
+	{Str: "\u0435\u0439\u0448", A: -1, B: 1, F: nil},
+	{Str: "\u044C", A: -1, B: 3, F: nil},
+	{Str: "\u0435\u0439\u0448\u0435", A: -1, B: 1, F: nil},
+	{Str: "\u043D", A: -1, B: 2, F: nil},
+}
+
+var russian_G_v = []byte{33, 65, 8, 232}
+
+type RussianStemmer struct {
+	Env
+}
+
+type RussianContext struct {
+	i_p2 int
+	i_pV int
+}
+
+func russian_r_mark_regions(env *RussianStemmer, ctx interface{}) bool {
+	context := ctx.(*RussianContext)
+	_ = context
+	context.i_pV = env.Limit
+	context.i_p2 = env.Limit
+	var v_1 = env.Cursor
+lab0:
+	for {
+	golab1:
+		for {
+		lab2:
+			for {
+				if !env.InGrouping(russian_G_v, 1072, 1103) {
+					break lab2
+				}
+				break golab1
+			}
+			if env.Cursor >= env.Limit {
+				break lab0
+			}
+			env.NextChar()
+		}
+		context.i_pV = env.Cursor
+	golab3:
+		for {
+		lab4:
+			for {
+				if !env.OutGrouping(russian_G_v, 1072, 1103) {
+					break lab4
+				}
+				break golab3
+			}
+			if env.Cursor >= env.Limit {
+				break lab0
+			}
+			env.NextChar()
+		}
+	golab5:
+		for {
+		lab6:
+			for {
+				if !env.InGrouping(russian_G_v, 1072, 1103) {
+					break lab6
+				}
+				break golab5
+			}
+			if env.Cursor >= env.Limit {
+				break lab0
+			}
+			env.NextChar()
+		}
+	golab7:
+		for {
+		lab8:
+			for {
+				if !env.OutGrouping(russian_G_v, 1072, 1103) {
+					break lab8
+				}
+				break golab7
+			}
+			if env.Cursor >= env.Limit {
+				break lab0
+			}
+			env.NextChar()
+		}
+		context.i_p2 = env.Cursor
+		break lab0
+	}
+	env.Cursor = v_1
+	return true
+}
+
+func russian_r_R2(env *RussianStemmer, ctx interface{}) bool {
+	context := ctx.(*RussianContext)
+	_ = context
+	if !(context.i_p2 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func russian_r_perfective_gerund(env *RussianStemmer, ctx interface{}) bool {
+	context := ctx.(*RussianContext)
+	_ = context
+	var among_var int32
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(russian_A_0, context)
+	if among_var == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if among_var == 0 {
+		return false
+	} else if among_var == 1 {
+	lab0:
+		for {
+			var v_1 = env.Limit - env.Cursor
+		lab1:
+			for {
+				if !env.EqSB("\u0430") {
+					break lab1
+				}
+				break lab0
+			}
+			env.Cursor = env.Limit - v_1
+			if !env.EqSB("\u044F") {
+				return false
+			}
+			break lab0
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 2 {
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_adjective(env *RussianStemmer, ctx interface{}) bool {
+	context := ctx.(*RussianContext)
+	_ = context
+	var among_var int32
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(russian_A_1, context)
+	if among_var == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if among_var == 0 {
+		return false
+	} else if among_var == 1 {
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_adjectival(env *RussianStemmer, ctx interface{}) bool {
+	context := ctx.(*RussianContext)
+	_ = context
+	var among_var int32
+	if !russian_r_adjective(env, context) {
+		return false
+	}
+	var v_1 = env.Limit - env.Cursor
+lab0:
+	for {
+		env.Ket = env.Cursor
+		among_var = env.FindAmongB(russian_A_2, context)
+		if among_var == 0 {
+			env.Cursor = env.Limit - v_1
+			break lab0
+		}
+		env.Bra = env.Cursor
+		if among_var == 0 {
+			env.Cursor = env.Limit - v_1
+			break lab0
+		} else if among_var == 1 {
+		lab1:
+			for {
+				var v_2 = env.Limit - env.Cursor
+			lab2:
+				for {
+					if !env.EqSB("\u0430") {
+						break lab2
+					}
+					break lab1
+				}
+				env.Cursor = env.Limit - v_2
+				if !env.EqSB("\u044F") {
+					env.Cursor = env.Limit - v_1
+					break lab0
+				}
+				break lab1
+			}
+			if !env.SliceDel() {
+				return false
+			}
+		} else if among_var == 2 {
+			if !env.SliceDel() {
+				return false
+			}
+		}
+		break lab0
+	}
+	return true
+}
+
+func russian_r_reflexive(env *RussianStemmer, ctx interface{}) bool {
+	context := ctx.(*RussianContext)
+	_ = context
+	var among_var int32
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(russian_A_3, context)
+	if among_var == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if among_var == 0 {
+		return false
+	} else if among_var == 1 {
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_verb(env *RussianStemmer, ctx interface{}) bool {
+	context := ctx.(*RussianContext)
+	_ = context
+	var among_var int32
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(russian_A_4, context)
+	if among_var == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if among_var == 0 {
+		return false
+	} else if among_var == 1 {
+	lab0:
+		for {
+			var v_1 = env.Limit - env.Cursor
+		lab1:
+			for {
+				if !env.EqSB("\u0430") {
+					break lab1
+				}
+				break lab0
+			}
+			env.Cursor = env.Limit - v_1
+			if !env.EqSB("\u044F") {
+				return false
+			}
+			break lab0
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 2 {
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_noun(env *RussianStemmer, ctx interface{}) bool {
+	context := ctx.(*RussianContext)
+	_ = context
+	var among_var int32
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(russian_A_5, context)
+	if among_var == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if among_var == 0 {
+		return false
+	} else if among_var == 1 {
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_derivational(env *RussianStemmer, ctx interface{}) bool {
+	context := ctx.(*RussianContext)
+	_ = context
+	var among_var int32
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(russian_A_6, context)
+	if among_var == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !russian_r_R2(env, context) {
+		return false
+	}
+	if among_var == 0 {
+		return false
+	} else if among_var == 1 {
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_tidy_up(env *RussianStemmer, ctx interface{}) bool {
+	context := ctx.(*RussianContext)
+	_ = context
+	var among_var int32
+	env.Ket = env.Cursor
+	among_var = env.FindAmongB(russian_A_7, context)
+	if among_var == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if among_var == 0 {
+		return false
+	} else if among_var == 1 {
+		if !env.SliceDel() {
+			return false
+		}
+		env.Ket = env.Cursor
+		if !env.EqSB("\u043D") {
+			return false
+		}
+		env.Bra = env.Cursor
+		if !env.EqSB("\u043D") {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 2 {
+		if !env.EqSB("\u043D") {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	} else if among_var == 3 {
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_stem(env *RussianStemmer) bool {
+	var context = &RussianContext{
+		i_p2: 0,
+		i_pV: 0,
+	}
+	_ = context
+	var v_1 = env.Cursor
+lab0:
+	for {
+		if !russian_r_mark_regions(env, context) {
+			break lab0
+		}
+		break lab0
+	}
+	env.Cursor = v_1
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit
+	var v_2 = env.Limit - env.Cursor
+	if env.Cursor < context.i_pV {
+		return false
+	}
+	env.Cursor = context.i_pV
+	var v_3 = env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v_2
+	var v_4 = env.Limit - env.Cursor
+lab1:
+	for {
+	lab2:
+		for {
+			var v_5 = env.Limit - env.Cursor
+		lab3:
+			for {
+				if !russian_r_perfective_gerund(env, context) {
+					break lab3
+				}
+				break lab2
+			}
+			env.Cursor = env.Limit - v_5
+			var v_6 = env.Limit - env.Cursor
+		lab4:
+			for {
+				if !russian_r_reflexive(env, context) {
+					env.Cursor = env.Limit - v_6
+					break lab4
+				}
+				break lab4
+			}
+		lab5:
+			for {
+				var v_7 = env.Limit - env.Cursor
+			lab6:
+				for {
+					if !russian_r_adjectival(env, context) {
+						break lab6
+					}
+					break lab5
+				}
+				env.Cursor = env.Limit - v_7
+			lab7:
+				for {
+					if !russian_r_verb(env, context) {
+						break lab7
+					}
+					break lab5
+				}
+				env.Cursor = env.Limit - v_7
+				if !russian_r_noun(env, context) {
+					break lab1
+				}
+				break lab5
+			}
+			break lab2
+		}
+		break lab1
+	}
+	env.Cursor = env.Limit - v_4
+	var v_8 = env.Limit - env.Cursor
+lab8:
+	for {
+		env.Ket = env.Cursor
+		if !env.EqSB("\u0438") {
+			env.Cursor = env.Limit - v_8
+			break lab8
+		}
+		env.Bra = env.Cursor
+		if !env.SliceDel() {
+			return false
+		}
+		break lab8
+	}
+	var v_9 = env.Limit - env.Cursor
+lab9:
+	for {
+		if !russian_r_derivational(env, context) {
+			break lab9
+		}
+		break lab9
+	}
+	env.Cursor = env.Limit - v_9
+	var v_10 = env.Limit - env.Cursor
+lab10:
+	for {
+		if !russian_r_tidy_up(env, context) {
+			break lab10
+		}
+		break lab10
+	}
+	env.Cursor = env.Limit - v_10
+	env.LimitBackward = v_3
+	env.Cursor = env.LimitBackward
+	return true
+}
+
+func (env *RussianStemmer) Stem(token string) string {
+	env.SetCurrent(token)
+	russian_stem(env)
+	return env.Current()
+}
@@ -0,0 +1,103 @@
+package snowball
+
+import "testing"
+
+// Excerpt of the reference vocabulary of the Russian stemmer
+var russianStems = []stemCase{
+	{"в", "в"},
+	{"вавиловка", "вавиловк"},
+	{"вагнера", "вагнер"},
+	{"вагон", "вагон"},
+	{"вагона", "вагон"},
+	{"вагоне", "вагон"},
+	{"вагонов", "вагон"},
+	{"вагоном", "вагон"},
+	{"вагоны", "вагон"},
+	{"важная", "важн"},
+	{"важнее", "важн"},
+	{"важнейшие", "важн"},
+	{"важнейшими", "важн"},
+	{"важничал", "важнича"},
+	{"важно", "важн"},
+	{"важного", "важн"},
+	{"важное", "важн"},
+	{"важной", "важн"},
+	{"важном", "важн"},
+	{"важному", "важн"},
+	{"важны", "важн"},
+	{"важные", "важн"},
+	{"важный", "важн"},
+	{"важным", "важн"},
+	{"важных", "важн"},
+	{"вазах", "ваз"},
+	{"вазы", "ваз"},
+	{"вакса", "вакс"},
+	{"вал", "вал"},
+	{"валандался", "валанда"},
+	{"валентина", "валентин"},
+	{"валерьяновых", "валерьянов"},
+	{"валетами", "валет"},
+	{"вали", "вал"},
+	{"валил", "вал"},
+	{"валился", "вал"},
+	{"валится", "вал"},
+	{"валить", "вал"},
+	{"валов", "вал"},
+	{"вальдшнепа", "вальдшнеп"},
+	{"вальс", "вальс"},
+	{"вальса", "вальс"},
+	{"вальсе", "вальс"},
+	{"вальсишку", "вальсишк"},
+	{"вальяжный", "вальяжн"},
+	{"валяется", "валя"},
+	{"валялась", "валя"},
+	{"валялись", "валя"},
+	{"валялось", "валя"},
+	{"валялся", "валя"},
+	{"валять", "валя"},
+	{"валяются", "валя"},
+	{"вам", "вам"},
+	{"вами", "вам"},
+	{"п", "п"},
+	{"па", "па"},
+	{"павел", "павел"},
+	{"павильон", "павильон"},
+	{"павильонам", "павильон"},
+	{"павла", "павл"},
+	{"павлиний", "павлин"},
+	{"павлины", "павлин"},
+	{"павлович", "павлович"},
+	{"павловна", "павловн"},
+	{"павловне", "павловн"},
+	{"павловной", "павловн"},
+	{"павловну", "павловн"},
+	{"павловны", "павловн"},
+	{"павловцы", "павловц"},
+	{"павлыч", "павлыч"},
+	{"павлыча", "павлыч"},
+	{"пагубная", "пагубн"},
+	{"падать", "пада"},
+	{"падающего", "пада"},
+	{"падающие", "пада"},
+	{"падающим", "пада"},
+	{"падеж", "падеж"},
+	{"падение", "паден"},
+	{"падением", "паден"},
+	{"падении", "паден"},
+	{"падений", "паден"},
+	{"падения", "паден"},
+	{"падет", "падет"},
+	{"падут", "падут"},
+	{"падучая", "падуч"},
+	{"паду", "пад"},
+	{"падшая", "падш"},
+	{"падшей", "падш"},
+	{"падшему", "падш"},
+	{"падший", "падш"},
+	{"падших", "падш"},
+	{"падшую", "падш"},
+}
+
+func TestRussianStemmer(t *testing.T) {
+	testStems(t, &RussianStemmer{}, russianStems)
+}
@@ -0,0 +1,21 @@
+package snowball
+
+import "testing"
+
+// Word of the reference vocabulary of Snowball along with its expected stem. The vocabularies are excerpts of the
+// voc.txt and output.txt pairs of https://github.com/snowballstem/snowball-data
+type stemCase struct {
+	word string
+	stem string
+}
+
+// Checks that the stemmer stems every word of the vocabulary into its expected stem. The same stemmer stems all the
+// words, so that any state leaking between the words is caught as well
+func testStems(t *testing.T, stemmer interface{ Stem(token string) string }, stems []stemCase) {
+	t.Helper()
+	for _, stem := range stems {
+		if got := stemmer.Stem(stem.word); got != stem.stem {
+			t.Errorf("Stem(%q) = %q, want %q", stem.word, got, stem.stem)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package snowball
+
+import "testing"
+
+// Excerpt of the reference vocabulary of the Spanish stemmer
+var spanishStems = []stemCase{
+	{"chico", "chic"},
+	{"chicos", "chic"},
+	{"chiffonier", "chiffoni"},
+	{"chihuahua", "chihuahu"},
+	{"chilaquiles", "chilaquil"},
+	{"chile", "chil"},
+	{"chilena", "chilen"},
+	{"chileno", "chilen"},
+	{"chilenos", "chilen"},
+	{"chiles", "chil"},
+	{"chilpancingo", "chilpancing"},
+	{"chimenea", "chimene"},
+	{"chin", "chin"},
+	{"china", "chin"},
+	{"chinas", "chin"},
+	{"chinches", "chinch"},
+	{"chinero", "chiner"},
+	{"chino", "chin"},
+	{"chinos", "chin"},
+	{"chip", "chip"},
+	{"chipotle", "chipotl"},
+	{"chips", "chips"},
+	{"chiquillo", "chiquill"},
+	{"chiquita", "chiquit"},
+	{"chirinos", "chirin"},
+	{"chismes", "chism"},
+	{"chispa", "chisp"},
+	{"chistes", "chist"},
+	{"chivo", "chiv"},
+	{"chocar", "choc"},
+	{"chocolate", "chocolat"},
+	{"chocó", "choc"},
+	{"chofer", "chof"},
+	{"chorrito", "chorrit"},
+	{"torá", "tor"},
+	{"tórax", "torax"},
+	{"torcer", "torc"},
+	{"toreado", "tor"},
+	{"toreados", "tor"},
+	{"toreándolo", "tor"},
+	{"torear", "tor"},
+	{"toreara", "tor"},
+	{"torearlo", "tor"},
+	{"toreo", "tore"},
+	{"torero", "torer"},
+	{"toreros", "torer"},
+	{"torio", "tori"},
+	{"tormenta", "torment"},
+	{"tormentas", "torment"},
+	{"tornado", "torn"},
+	{"tornados", "torn"},
+	{"tornar", "torn"},
+	{"torneo", "torne"},
+	{"toro", "tor"},
+	{"toronto", "toront"},
+	{"toros", "tor"},
+	{"torpeza", "torpez"},
+	{"torre", "torr"},
+	{"torrencial", "torrencial"},
+	{"torrente", "torrent"},
+	{"tortas", "tort"},
+	{"tortilla", "tortill"},
+	{"tortuga", "tortug"},
+	{"tortura", "tortur"},
+}
+
+func TestSpanishStemmer(t *testing.T) {
+	testStems(t, &SpanishStemmer{}, spanishStems)
+}
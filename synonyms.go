@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"gosen/queryParser"
+	"gosen/slog"
+	"gosen/tfIndex"
+	"os"
+	"strings"
+)
+
+// Default multiplier of the boosts of the synonyms of the words and the phrases of the queries
+const defaultSynonymBoost float64 = 0.8
+
+// Synonyms of the words and the phrases of the queries, keyed by their words as analyzed in a language by the analyzer
+// of the index and joined by spaces
+type thesaurus map[string][]string
+
+// Synonyms expanding the queries keyed by the languages the queries are analyzed in, empty unless -synonyms is given
+var querySynonyms = map[string]thesaurus{}
+
+// Returns the key of the text in the thesaurus of the language
+func thesaurusKey(text string, language string) string {
+	tokens, _ := words(text, language)
+	return strings.Join(tokens, " ")
+}
+
+// Adds the synonyms of the text in the language, skipping the text itself and the synonyms already added
+func (t thesaurus) add(text string, synonyms []string, language string) {
+	key := thesaurusKey(text, language)
+	if key == "" {
+		return
+	}
+	for _, synonym := range synonyms {
+		if synonym == text || thesaurusKey(synonym, language) == key {
+			continue
+		}
+		duplicate := false
+		for _, existing := range t[key] {
+			duplicate = duplicate || existing == synonym
+		}
+		if !duplicate {
+			t[key] = append(t[key], synonym)
+		}
+	}
+}
+
+// Splits the comma separated words or phrases, dropping the empty ones
+func splitSynonyms(list string) []string {
+	ret := []string{}
+	for _, synonym := range strings.Split(list, ",") {
+		if synonym = strings.Join(strings.Fields(synonym), " "); synonym != "" {
+			ret = append(ret, synonym)
+		}
+	}
+	return ret
+}
+
+// Line of the thesaurus, each of the words or the phrases expands into the synonyms
+type thesaurusEntry struct {
+	texts    []string
+	synonyms []string
+}
+
+// Reads the entries of the thesaurus from a plain text file, every line lists either equivalent words or phrases
+// separated by commas (k8s, kubernetes), each one expanding into the others, or words or phrases expanding one way into
+// others (lb => load balancer). The empty lines and the lines starting with `#` are skipped
+func readThesaurus(filePath string) ([]thesaurusEntry, error) {
+	bytes, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("readThesaurus: cannot read the synonyms from `%s`: %w", filePath, err)
+	}
+	ret := []thesaurusEntry{}
+	for i, line := range strings.Split(string(bytes), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if from, to, oneWay := strings.Cut(line, "=>"); oneWay {
+			texts, synonyms := splitSynonyms(from), splitSynonyms(to)
+			if len(texts) == 0 || len(synonyms) == 0 {
+				return nil, fmt.Errorf("readThesaurus: `%s` line %d: expected <words> => <synonyms>, got `%s`", filePath, i+1, line)
+			}
+			ret = append(ret, thesaurusEntry{texts: texts, synonyms: synonyms})
+			continue
+		}
+		synonyms := splitSynonyms(line)
+		if len(synonyms) < 2 {
+			return nil, fmt.Errorf("readThesaurus: `%s` line %d: expected at least two comma separated synonyms, got `%s`", filePath, i+1, line)
+		}
+		ret = append(ret, thesaurusEntry{texts: synonyms, synonyms: synonyms})
+	}
+	return ret, nil
+}
+
+// Returns the thesaurus of the entries keyed by their words analyzed in the language, which is the language the
+// queries are analyzed in, see queryLanguage
+func mkThesaurus(entries []thesaurusEntry, language string) thesaurus {
+	ret := thesaurus{}
+	for _, entry := range entries {
+		for _, text := range entry.texts {
+			ret.add(text, entry.synonyms, language)
+		}
+	}
+	return ret
+}
+
+// Loads the thesaurus given by -synonyms into querySynonyms, in each of the languages the queries can be analyzed in.
+// The analyzer of the index must be loaded first
+func loadSynonyms() {
+	if synonymsPath == "" {
+		return
+	}
+	if synonymBoost <= 0.0 {
+		slog.Fatalf("loadSynonyms: -synonymBoost must be positive, got %g", synonymBoost)
+	}
+	entries, err := readThesaurus(synonymsPath)
+	if err != nil {
+		slog.Fatal(err)
+	}
+	for _, language := range textAnalyzer.Languages() {
+		querySynonyms[language] = mkThesaurus(entries, language)
+	}
+	slog.Infof("Loaded %d lines of synonyms from `%s`", len(entries), synonymsPath)
+}
+
+// Returns the query of the phrase, a term query when the phrase has a single token
+func phraseOrTerm(phrase tfIndex.PhraseQuery) tfIndex.SearchQuery {
+	if len(phrase.Tokens) == 1 {
+		return tfIndex.TermQuery{Token: phrase.Tokens[0], Expansions: phrase.Expansions, Boost: phrase.Boost, SynonymOf: phrase.SynonymOf}
+	}
+	return phrase
+}
+
+// Returns the queries of the synonyms of the word or the phrase in the fields, boosted by synonymBoost
+func synonymQueries(fields []string, text string, language string) []tfIndex.SearchQuery {
+	ret := []tfIndex.SearchQuery{}
+	for _, synonym := range querySynonyms[language][thesaurusKey(text, language)] {
+		for _, field := range fields {
+			if phrase := mkPhrase(field, synonym, language); phrase != nil {
+				phrase.Boost *= synonymBoost
+				phrase.SynonymOf = text
+				ret = append(ret, phraseOrTerm(*phrase))
+			}
+		}
+	}
+	return ret
+}
+
+// Returns the queries of the synonyms of the runs of the juxtaposed unscoped words of the query (e.g. load balancer),
+// for the synonyms of multiple words
+func multiWordSynonymQueries(nodes []queryParser.Node, language string) []tfIndex.SearchQuery {
+	ret := []tfIndex.SearchQuery{}
+	if len(querySynonyms[language]) == 0 {
+		return ret
+	}
+	for start := range nodes {
+		texts := []string{}
+		for _, node := range nodes[start:] {
+			term, ok := node.(*queryParser.Term)
+			if !ok || term.Field != "" {
+				break
+			}
+			texts = append(texts, term.Text)
+			if len(texts) > 1 {
+				ret = append(ret, synonymQueries(defaultFields, strings.Join(texts, " "), language)...)
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,104 @@
+package tfIndex
+
+import (
+	"sort"
+	"sync"
+)
+
+// Dictionary of the indexed tokens in their byte order, walked as a trie by seeking the tokens following its nodes or
+// scanned by the ranges of the prefixes
+type termDictionary interface {
+	// Returns the smallest indexed token greater than or equal to from, ok is false when there is none
+	seek(from string) (token string, ok bool, err error)
+	// Calls visit with the indexed tokens starting with the prefix in their byte order, until visit returns false
+	scan(prefix string, visit func(token string) bool) error
+}
+
+// Returns the smallest string greater than all the strings starting with the prefix, ok is false when there is none
+func successor(prefix string) (string, bool) {
+	bytes := []byte(prefix)
+	for i := len(bytes) - 1; i >= 0; i-- {
+		if bytes[i] < 0xFF {
+			bytes[i]++
+			return string(bytes[:i+1]), true
+		}
+	}
+	return "", false
+}
+
+// Returns the query with its fuzzy and wildcard queries replaced by the term queries of their tokens, the tokens of the
+// dictionary they match being the expansions of the exact tokens, which match the documents as exactly as them
+func resolveExpansions(dictionary termDictionary, searchQuery SearchQuery) (SearchQuery, error) {
+	switch searchQuery := searchQuery.(type) {
+	case FuzzyQuery:
+		matches, err := fuzzyTokens(dictionary, searchQuery.Prefix, searchQuery.Token, min(searchQuery.MaxDistance, MaxFuzzyDistance))
+		if err != nil {
+			return nil, err
+		}
+		ret := searchQuery.exact()
+		ret.ExpansionsMatch = true
+		for token, distance := range matches {
+			if token != ret.Token {
+				ret.Expansions = append(ret.Expansions, Expansion{Token: token, Boost: 1.0 / float64(1+distance)})
+			}
+		}
+		sort.Slice(ret.Expansions, func(i, j int) bool { return ret.Expansions[i].Token < ret.Expansions[j].Token })
+		return ret, nil
+	case WildcardQuery:
+		matches, err := wildcardTokens(dictionary, searchQuery.Prefix, searchQuery.Pattern, searchQuery.MaxExpansions)
+		if err != nil {
+			return nil, err
+		}
+		ret := searchQuery.exact()
+		ret.ExpansionsMatch = true
+		for _, token := range matches {
+			ret.Expansions = append(ret.Expansions, Expansion{Token: token})
+		}
+		return ret, nil
+	case BooleanQuery:
+		ret := BooleanQuery{}
+		for _, clauses := range []struct {
+			from []SearchQuery
+			to   *[]SearchQuery
+		}{{searchQuery.Optional, &ret.Optional}, {searchQuery.Required, &ret.Required}, {searchQuery.Excluded, &ret.Excluded}} {
+			for _, child := range clauses.from {
+				resolved, err := resolveExpansions(dictionary, child)
+				if err != nil {
+					return nil, err
+				}
+				*clauses.to = append(*clauses.to, resolved)
+			}
+		}
+		return ret, nil
+	default:
+	}
+	return searchQuery, nil
+}
+
+// Indexed tokens (or words) of SimpleTFINdex in their byte order, sorted lazily once the index has changed
+type sortedTokens struct {
+	lock   sync.Mutex
+	stale  bool
+	tokens []string
+}
+
+// Returns the sorted tokens keying their document frequencies, sorting them again when they are stale
+func (sorted *sortedTokens) get(docFrequencies map[string]uint) []string {
+	sorted.lock.Lock()
+	defer sorted.lock.Unlock()
+	if sorted.stale || sorted.tokens == nil {
+		sorted.tokens = make([]string, 0, len(docFrequencies))
+		for token := range docFrequencies {
+			sorted.tokens = append(sorted.tokens, token)
+		}
+		sort.Strings(sorted.tokens)
+		sorted.stale = false
+	}
+	return sorted.tokens
+}
+
+func (sorted *sortedTokens) invalidate() {
+	sorted.lock.Lock()
+	defer sorted.lock.Unlock()
+	sorted.stale = true
+}
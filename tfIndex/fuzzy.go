@@ -0,0 +1,100 @@
+package tfIndex
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Largest edit distance of the fuzzy queries, the larger ones match too many tokens to be useful
+const MaxFuzzyDistance uint = 2
+
+// Word matching the indexed tokens made of Prefix (e.g. the field of the tokens) followed by a suffix within
+// MaxDistance edits of Token, see FuzzyTokens. The matches are boosted by 1/(1+distance) on top of Boost, an unset
+// (zero) Boost is treated as 1. The tokens with a `:` after the prefix, i.e. the tokens of the other fields, never match
+type FuzzyQuery struct {
+	Prefix      string
+	Token       string
+	MaxDistance uint
+	Boost       float64
+}
+
+// Term query of the exact token, the fuzzy queries are resolved into term queries before being evaluated, see
+// resolveExpansions
+func (fuzzyQuery FuzzyQuery) exact() TermQuery {
+	return TermQuery{Token: fuzzyQuery.Prefix + fuzzyQuery.Token, Boost: fuzzyQuery.Boost}
+}
+
+func (fuzzyQuery FuzzyQuery) collect(collector *queryCollector, positive bool) {
+	fuzzyQuery.exact().collect(collector, positive)
+}
+
+func (fuzzyQuery FuzzyQuery) match(postingsByToken map[string]map[string]posting) (docSet, docSet) {
+	return fuzzyQuery.exact().match(postingsByToken)
+}
+
+// Returns the indexed tokens made of the prefix followed by a suffix within maxDistance edits of the token, keyed by
+// token with their distances. The distance is the optimal string alignment distance over the runes, i.e. the
+// Levenshtein distance counting the transpositions of adjacent runes as single edits. The dictionary is walked as a
+// trie, skipping the subtrees whose prefixes are already too far from the token
+func fuzzyTokens(dictionary termDictionary, prefix string, token string, maxDistance uint) (map[string]uint, error) {
+	target := []rune(token)
+	ret := map[string]uint{}
+	// row[j] is the distance between the suffix of the node and the first j runes of the token, previousRow is the
+	// row of the parent node and last the last rune of the node, both needed for the transpositions
+	var walk func(node string, last rune, previousRow []uint, row []uint) error
+	walk = func(node string, last rune, previousRow []uint, row []uint) error {
+		if slices.Min(row) > maxDistance {
+			return nil
+		}
+		next, ok, err := dictionary.seek(node)
+		if err != nil || !ok || !strings.HasPrefix(next, node) {
+			return err
+		}
+		if next == node {
+			if row[len(target)] <= maxDistance && node != prefix {
+				ret[node] = row[len(target)]
+			}
+			if next, ok, err = dictionary.seek(node + "\x00"); err != nil {
+				return err
+			}
+		}
+		for ok && strings.HasPrefix(next, node) {
+			r, size := utf8.DecodeRuneInString(next[len(node):])
+			child := next[:len(node)+size]
+			if r != ':' {
+				childRow := make([]uint, len(target)+1)
+				childRow[0] = row[0] + 1
+				for j := 1; j <= len(target); j++ {
+					cost := uint(1)
+					if target[j-1] == r {
+						cost = 0
+					}
+					childRow[j] = min(row[j]+1, childRow[j-1]+1, row[j-1]+cost)
+					if previousRow != nil && j > 1 && target[j-2] == r && target[j-1] == last {
+						childRow[j] = min(childRow[j], previousRow[j-2]+1)
+					}
+				}
+				if err := walk(child, r, row, childRow); err != nil {
+					return err
+				}
+			}
+			from, more := successor(child)
+			if !more {
+				return nil
+			}
+			if next, ok, err = dictionary.seek(from); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	firstRow := make([]uint, len(target)+1)
+	for j := range firstRow {
+		firstRow[j] = uint(j)
+	}
+	if err := walk(prefix, utf8.RuneError, nil, firstRow); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,59 @@
+package tfIndex
+
+import (
+	"maps"
+	"testing"
+)
+
+type fuzzyCase struct {
+	prefix      string
+	token       string
+	maxDistance uint
+	// Matching tokens keyed by token with their distances
+	matches map[string]uint
+}
+
+var fuzzyCases = []fuzzyCase{
+	{"", "raft", 0, map[string]uint{"raft": 0}},
+	{"", "raft", 2, map[string]uint{"raft": 0}},
+	{"", "rafts", 1, map[string]uint{"raft": 1}},
+	{"", "rfat", 1, map[string]uint{"raft": 1}},
+	{"", "rfat", 0, map[string]uint{}},
+	{"", "leadr", 1, map[string]uint{"leader": 1}},
+	{"", "elcetion", 1, map[string]uint{"election": 1}},
+	{"", "tmeout", 1, map[string]uint{"timeout": 1}},
+	{"", "pol", 1, map[string]uint{"pool": 1}},
+	{"", "sise", 1, map[string]uint{"size": 1}},
+	{"", "reach", 1, map[string]uint{}},
+	{"", "reach", 2, map[string]uint{"reached": 2}},
+	{"title:", "rafd", 1, map[string]uint{"title:raft": 1}},
+	{"title:", "", 2, map[string]uint{}},
+	{"path:", "raft", 2, map[string]uint{}},
+}
+
+func TestFuzzyTokens(t *testing.T) {
+	for name, index := range testIndexes(t) {
+		for _, c := range fuzzyCases {
+			matches, err := index.FuzzyTokens(c.prefix, c.token, c.maxDistance)
+			if err != nil {
+				t.Errorf("%s: FuzzyTokens(%q, %q, %d) failed: %s", name, c.prefix, c.token, c.maxDistance, err)
+				continue
+			}
+			if !maps.Equal(matches, c.matches) {
+				t.Errorf("%s: FuzzyTokens(%q, %q, %d) = %v, want %v", name, c.prefix, c.token, c.maxDistance, matches, c.matches)
+			}
+		}
+	}
+}
+
+var fuzzyMatches = []matchCase{
+	{FuzzyQuery{Token: "leadr", MaxDistance: 1}, []string{"a", "b"}},
+	{FuzzyQuery{Token: "leadr", MaxDistance: 0}, []string{}},
+	{FuzzyQuery{Token: "sise", MaxDistance: 1}, []string{"c"}},
+	{FuzzyQuery{Prefix: "title:", Token: "rafd", MaxDistance: 1}, []string{"d"}},
+	{BooleanQuery{Required: []SearchQuery{FuzzyQuery{Token: "rfat", MaxDistance: 1}}, Excluded: terms("timeout")}, []string{"b"}},
+}
+
+func TestFuzzyQuery(t *testing.T) {
+	testMatches(t, fuzzyMatches)
+}
@@ -0,0 +1,110 @@
+package tfIndex
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	TFIDFScorerName string = "tfidf"
+	BM25ScorerName         = "bm25"
+)
+
+const (
+	DefaultBM25K1 float64 = 1.2
+	DefaultBM25B          = 0.75
+)
+
+// Statistics required for scoring a single token against a single document
+type TermStats struct {
+	Frequency      uint    `json:"frequency"`
+	DocFrequency   uint    `json:"docFrequency"`
+	TotalDocuments uint    `json:"totalDocuments"`
+	DocLength      uint    `json:"docLength"`
+	AvgDocLength   float64 `json:"avgDocLength"`
+}
+
+// Breakdown of the score of a single token against a single document, Score = IDF * TFWeight
+type ScoreDetails struct {
+	IDF float64 `json:"idf"`
+	// Weight of the term frequency, after the saturation and the document length normalization of the scorer
+	TFWeight float64 `json:"tfWeight"`
+	// Document length normalization factor, 1 when the scorer does not normalize
+	LengthNorm float64 `json:"lengthNorm"`
+	Score      float64 `json:"score"`
+}
+
+// Scorer computes the contribution of a single token towards the relevance of a document
+type Scorer interface {
+	Name() string
+	Score(stats TermStats) float64
+	Explain(stats TermStats) ScoreDetails
+}
+
+// Classic tf-idf scoring: frequency * log(N / df)
+type TFIDFScorer struct{}
+
+func (TFIDFScorer) Name() string {
+	return TFIDFScorerName
+}
+
+func (TFIDFScorer) IDF(docFrequency uint, totalDocuments uint) float64 {
+	if docFrequency == 0 {
+		return 0.0
+	}
+	return math.Log(float64(totalDocuments) / float64(docFrequency))
+}
+
+func (tfidf TFIDFScorer) Score(stats TermStats) float64 {
+	return tfidf.Explain(stats).Score
+}
+
+func (tfidf TFIDFScorer) Explain(stats TermStats) ScoreDetails {
+	idf, tf := tfidf.IDF(stats.DocFrequency, stats.TotalDocuments), float64(stats.Frequency)
+	return ScoreDetails{IDF: idf, TFWeight: tf, LengthNorm: 1.0, Score: tf * idf}
+}
+
+// Okapi BM25 scoring, K1 controls the term frequency saturation and B controls the document length normalization
+type BM25Scorer struct {
+	K1 float64
+	B  float64
+}
+
+func (BM25Scorer) Name() string {
+	return BM25ScorerName
+}
+
+func (BM25Scorer) IDF(docFrequency uint, totalDocuments uint) float64 {
+	n, df := float64(totalDocuments), float64(docFrequency)
+	return math.Log(1.0 + (n-df+0.5)/(df+0.5))
+}
+
+func (bm25 BM25Scorer) Score(stats TermStats) float64 {
+	return bm25.Explain(stats).Score
+}
+
+func (bm25 BM25Scorer) Explain(stats TermStats) ScoreDetails {
+	idf := bm25.IDF(stats.DocFrequency, stats.TotalDocuments)
+	norm := 1.0
+	if stats.AvgDocLength > 0.0 {
+		norm = 1.0 - bm25.B + bm25.B*float64(stats.DocLength)/stats.AvgDocLength
+	}
+	if stats.Frequency == 0 {
+		return ScoreDetails{IDF: idf, LengthNorm: norm}
+	}
+	tf := float64(stats.Frequency)
+	tfWeight := (tf * (bm25.K1 + 1.0)) / (tf + bm25.K1*norm)
+	return ScoreDetails{IDF: idf, TFWeight: tfWeight, LengthNorm: norm, Score: idf * tfWeight}
+}
+
+// Construct a Scorer from its name, k1 and b are only used by bm25
+func NewScorer(name string, k1 float64, b float64) (Scorer, error) {
+	switch name {
+	case TFIDFScorerName:
+		return TFIDFScorer{}, nil
+	case BM25ScorerName:
+		return BM25Scorer{K1: k1, B: b}, nil
+	default:
+	}
+	return nil, fmt.Errorf("NewScorer: unknown scorer `%s`, supported scorers: [%s, %s]", name, TFIDFScorerName, BM25ScorerName)
+}
@@ -0,0 +1,552 @@
+package tfIndex
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Boost applied to the score of phrase and proximity matches over the scattered term matches
+const PhraseBoost float64 = 2.0
+
+// Node of the query tree evaluated by the indexes, one of TermQuery, PhraseQuery, NearQuery, FuzzyQuery,
+// WildcardQuery or BooleanQuery
+type SearchQuery interface {
+	// Walks the query collecting its tokens, positive is false for the parts of the query which are excluded
+	collect(collector *queryCollector, positive bool)
+	// Returns the documents loosely matching the query (containing any of its tokens) and the ones exactly matching it
+	match(postingsByToken map[string]map[string]posting) (loose docSet, exact docSet)
+}
+
+// Token accompanying the tokens of a query (e.g. one of their ngrams), which only loosely matches the documents and
+// contributes towards their scores. Boost multiplies the boost of the query, an unset (zero) Boost is treated as 1
+type Expansion struct {
+	Token string
+	Boost float64
+}
+
+// Single token, matched exactly when a document contains the token. The expansions of the token only loosely match the
+// documents, unless ExpansionsMatch is set (e.g. for the tokens a fuzzy word or a pattern is resolved into). Boost
+// multiplies the scores of the tokens, an unset (zero) Boost is treated as 1. SynonymOf is the word or the phrase of the
+// query the token is a synonym of, only shown by the explanations
+type TermQuery struct {
+	Token           string
+	Expansions      []Expansion
+	ExpansionsMatch bool
+	Boost           float64
+	SynonymOf       string
+}
+
+// Sequence of tokens which must appear in a document at the given relative positions
+type PhraseQuery struct {
+	Tokens     []string
+	Positions  []uint
+	Expansions []Expansion
+	Boost      float64
+	SynonymOf  string
+}
+
+// Proximity constraint, satisfied when both the phrases appear within Distance positions of each other
+type NearQuery struct {
+	Left     PhraseQuery
+	Right    PhraseQuery
+	Distance uint
+	Boost    float64
+}
+
+func boostOrDefault(boost float64) float64 {
+	if boost == 0.0 {
+		return 1.0
+	}
+	return boost
+}
+
+// Matches the documents matching all the Required clauses and none of the Excluded clauses. When there are no
+// Required clauses, the documents must also match at least one of the Optional clauses
+type BooleanQuery struct {
+	Optional []SearchQuery
+	Required []SearchQuery
+	Excluded []SearchQuery
+}
+
+type docSet = map[string]bool
+
+func union(sets ...docSet) docSet {
+	ret := docSet{}
+	for _, set := range sets {
+		for docId := range set {
+			ret[docId] = true
+		}
+	}
+	return ret
+}
+
+func intersection(a docSet, b docSet) docSet {
+	ret := docSet{}
+	for docId := range a {
+		if b[docId] {
+			ret[docId] = true
+		}
+	}
+	return ret
+}
+
+func difference(a docSet, b docSet) docSet {
+	ret := docSet{}
+	for docId := range a {
+		if !b[docId] {
+			ret[docId] = true
+		}
+	}
+	return ret
+}
+
+func containing(postingsByToken map[string]map[string]posting, tokens ...string) docSet {
+	ret := docSet{}
+	for _, token := range tokens {
+		for docId := range postingsByToken[token] {
+			ret[docId] = true
+		}
+	}
+	return ret
+}
+
+// Tokens, phrases and proximity constraints of a query
+type queryCollector struct {
+	// All the tokens referred by the query
+	tokens map[string]bool
+	// Tokens whose positions are needed for evaluating the query
+	positional map[string]bool
+	// Unique tokens, phrases and proximity constraints contributing towards the score, in the order of appearance
+	scoring []boostedToken
+	phrases []PhraseQuery
+	nears   []NearQuery
+}
+
+// Token along with the multiplier of its score
+type boostedToken struct {
+	token string
+	boost float64
+	// Token of the query expanded into this token, empty when the token is not an expansion
+	expansionOf string
+	// Word or phrase of the query this token is a synonym of, empty when the token is not a synonym
+	synonymOf string
+}
+
+func newQueryCollector() *queryCollector {
+	return &queryCollector{tokens: map[string]bool{}, positional: map[string]bool{}}
+}
+
+// Adds the tokens to the collector, a token contributing more than once towards the score keeps its highest boost
+func (collector *queryCollector) add(positive bool, boost float64, expansionOf string, synonymOf string, tokens ...string) {
+	for _, token := range tokens {
+		collector.tokens[token] = true
+		if positive {
+			collector.scoring = addBoostedToken(collector.scoring, boostedToken{token, boostOrDefault(boost), expansionOf, synonymOf})
+		}
+	}
+}
+
+// Adds the expansions of the tokens of a query boosted by the boost to the collector
+func (collector *queryCollector) addExpansions(positive bool, boost float64, expansionOf string, synonymOf string, expansions []Expansion) {
+	for _, expansion := range expansions {
+		collector.add(positive, boostOrDefault(boost)*boostOrDefault(expansion.Boost), expansionOf, synonymOf, expansion.Token)
+	}
+}
+
+// Returns the tokens of the expansions
+func expansionTokens(expansions []Expansion) []string {
+	ret := make([]string, len(expansions))
+	for i, expansion := range expansions {
+		ret[i] = expansion.Token
+	}
+	return ret
+}
+
+func addBoostedToken(boostedTokens []boostedToken, toAdd boostedToken) []boostedToken {
+	for i, boosted := range boostedTokens {
+		if boosted.token == toAdd.token {
+			// the token is explained as the occurrence with the highest boost
+			if toAdd.boost > boosted.boost {
+				boostedTokens[i] = toAdd
+			}
+			return boostedTokens
+		}
+	}
+	return append(boostedTokens, toAdd)
+}
+
+func (termQuery TermQuery) collect(collector *queryCollector, positive bool) {
+	collector.add(positive, termQuery.Boost, "", termQuery.SynonymOf, termQuery.Token)
+	if positive {
+		collector.addExpansions(positive, termQuery.Boost, termQuery.Token, termQuery.SynonymOf, termQuery.Expansions)
+	}
+}
+
+func (termQuery TermQuery) match(postingsByToken map[string]map[string]posting) (docSet, docSet) {
+	exact := containing(postingsByToken, termQuery.Token)
+	loose := union(exact, containing(postingsByToken, expansionTokens(termQuery.Expansions)...))
+	if termQuery.ExpansionsMatch {
+		return loose, loose
+	}
+	return loose, exact
+}
+
+func (phraseQuery PhraseQuery) collect(collector *queryCollector, positive bool) {
+	collector.add(positive, phraseQuery.Boost, "", phraseQuery.SynonymOf, phraseQuery.Tokens...)
+	for _, token := range phraseQuery.Tokens {
+		collector.positional[token] = true
+	}
+	if positive {
+		collector.addExpansions(positive, phraseQuery.Boost, phraseQuery.String(), phraseQuery.SynonymOf, phraseQuery.Expansions)
+		collector.phrases = append(collector.phrases, phraseQuery)
+	}
+}
+
+// Renders the tokens of the phrase, prefixing the tokens by their relative positions when they are not consecutive
+func (phraseQuery PhraseQuery) String() string {
+	parts := make([]string, len(phraseQuery.Tokens))
+	consecutive := true
+	for i, position := range phraseQuery.Positions {
+		consecutive = consecutive && position == uint(i)
+	}
+	for i, token := range phraseQuery.Tokens {
+		parts[i] = token
+		if !consecutive {
+			parts[i] = fmt.Sprintf("%d:%s", phraseQuery.Positions[i], token)
+		}
+	}
+	return fmt.Sprintf("%q", strings.Join(parts, " "))
+}
+
+func (nearQuery NearQuery) String() string {
+	return fmt.Sprintf("%s NEAR/%d %s", nearQuery.Left, nearQuery.Distance, nearQuery.Right)
+}
+
+// Returns the number of occurrences of the phrase keyed by docId
+func (phraseQuery PhraseQuery) frequencies(postingsByToken map[string]map[string]posting) map[string]uint {
+	ret := map[string]uint{}
+	if len(phraseQuery.Tokens) == 0 {
+		return ret
+	}
+	for docId := range postingsByToken[phraseQuery.Tokens[0]] {
+		if occurrences := phraseOccurrences(phraseQuery, postingsByToken, docId); len(occurrences) > 0 {
+			ret[docId] = uint(len(occurrences))
+		}
+	}
+	return ret
+}
+
+func (phraseQuery PhraseQuery) match(postingsByToken map[string]map[string]posting) (docSet, docSet) {
+	exact := docSet{}
+	for docId := range phraseQuery.frequencies(postingsByToken) {
+		exact[docId] = true
+	}
+	loose := containing(postingsByToken, phraseQuery.Tokens...)
+	return union(loose, containing(postingsByToken, expansionTokens(phraseQuery.Expansions)...)), exact
+}
+
+func (nearQuery NearQuery) collect(collector *queryCollector, positive bool) {
+	for _, phraseQuery := range []PhraseQuery{nearQuery.Left, nearQuery.Right} {
+		collector.add(positive, nearQuery.Boost, "", phraseQuery.SynonymOf, phraseQuery.Tokens...)
+		for _, token := range phraseQuery.Tokens {
+			collector.positional[token] = true
+		}
+		if positive {
+			collector.addExpansions(positive, nearQuery.Boost, phraseQuery.String(), phraseQuery.SynonymOf, phraseQuery.Expansions)
+		}
+	}
+	if positive {
+		collector.nears = append(collector.nears, nearQuery)
+	}
+}
+
+// Returns the number of occurrences of the left phrase having the right phrase within the distance, keyed by docId
+func (nearQuery NearQuery) frequencies(postingsByToken map[string]map[string]posting) map[string]uint {
+	ret := map[string]uint{}
+	if len(nearQuery.Left.Tokens) == 0 {
+		return ret
+	}
+	for docId := range postingsByToken[nearQuery.Left.Tokens[0]] {
+		if occurrences := nearOccurrences(nearQuery, postingsByToken, docId); occurrences > 0 {
+			ret[docId] = occurrences
+		}
+	}
+	return ret
+}
+
+func (nearQuery NearQuery) match(postingsByToken map[string]map[string]posting) (docSet, docSet) {
+	exact := docSet{}
+	for docId := range nearQuery.frequencies(postingsByToken) {
+		exact[docId] = true
+	}
+	leftLoose, _ := nearQuery.Left.match(postingsByToken)
+	rightLoose, _ := nearQuery.Right.match(postingsByToken)
+	return union(leftLoose, rightLoose), exact
+}
+
+func (booleanQuery BooleanQuery) collect(collector *queryCollector, positive bool) {
+	for _, child := range booleanQuery.Optional {
+		child.collect(collector, positive)
+	}
+	for _, child := range booleanQuery.Required {
+		child.collect(collector, positive)
+	}
+	for _, child := range booleanQuery.Excluded {
+		child.collect(collector, false)
+	}
+}
+
+func (booleanQuery BooleanQuery) match(postingsByToken map[string]map[string]posting) (docSet, docSet) {
+	excluded := docSet{}
+	for _, child := range booleanQuery.Excluded {
+		_, exact := child.match(postingsByToken)
+		excluded = union(excluded, exact)
+	}
+	if len(booleanQuery.Required) > 0 {
+		var matched docSet
+		for i, child := range booleanQuery.Required {
+			_, exact := child.match(postingsByToken)
+			if i == 0 {
+				matched = exact
+			} else {
+				matched = intersection(matched, exact)
+			}
+		}
+		matched = difference(matched, excluded)
+		return matched, matched
+	}
+	loose, exact := docSet{}, docSet{}
+	for _, child := range booleanQuery.Optional {
+		childLoose, childExact := child.match(postingsByToken)
+		loose, exact = union(loose, childLoose), union(exact, childExact)
+	}
+	return difference(loose, excluded), difference(exact, excluded)
+}
+
+// Returns the unique tokens of the query when it is a plain bag of tokens, i.e. having no phrases, proximity
+// constraints, required or excluded clauses
+func plainTokens(searchQuery SearchQuery) ([]boostedToken, bool) {
+	switch searchQuery := searchQuery.(type) {
+	case TermQuery:
+		collector := newQueryCollector()
+		searchQuery.collect(collector, true)
+		return collector.scoring, true
+	case BooleanQuery:
+		if len(searchQuery.Required) > 0 || len(searchQuery.Excluded) > 0 {
+			return nil, false
+		}
+		ret := []boostedToken{}
+		for _, child := range searchQuery.Optional {
+			boostedTokens, ok := plainTokens(child)
+			if !ok {
+				return nil, false
+			}
+			for _, boosted := range boostedTokens {
+				ret = addBoostedToken(ret, boosted)
+			}
+		}
+		return ret, true
+	default:
+	}
+	return nil, false
+}
+
+// Occurrence of a token in a document
+type posting struct {
+	frequency uint
+	positions []uint
+	docLength uint
+}
+
+// Source of postings used by the query evaluator, implemented by both the backends
+type postingsSource interface {
+	corpusStats() (totalDocuments uint, avgDocLength float64, err error)
+	// Returns the postings of the token keyed by docId, positions are only needed when withPositions is set
+	postings(token string, withPositions bool) (map[string]posting, error)
+}
+
+// Returns the starting positions of all the occurrences of the phrase in a document
+func phraseOccurrences(phrase PhraseQuery, postingsByToken map[string]map[string]posting, docId string) []uint {
+	if len(phrase.Tokens) == 0 {
+		return nil
+	}
+	positionSets := make([]map[uint]bool, len(phrase.Tokens))
+	for i, token := range phrase.Tokens {
+		p, ok := postingsByToken[token][docId]
+		if !ok {
+			return nil
+		}
+		positionSets[i] = map[uint]bool{}
+		for _, position := range p.positions {
+			positionSets[i][position] = true
+		}
+	}
+	ret := []uint{}
+	for start := range positionSets[0] {
+		if start < phrase.Positions[0] {
+			continue
+		}
+		origin := start - phrase.Positions[0]
+		matched := true
+		for i := 1; i < len(phrase.Tokens) && matched; i++ {
+			matched = positionSets[i][origin+phrase.Positions[i]]
+		}
+		if matched {
+			ret = append(ret, start)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+	return ret
+}
+
+// Returns the number of occurrences of the left phrase having the right phrase within the given distance
+func nearOccurrences(near NearQuery, postingsByToken map[string]map[string]posting, docId string) uint {
+	lefts := phraseOccurrences(near.Left, postingsByToken, docId)
+	if len(lefts) == 0 {
+		return 0
+	}
+	rights := phraseOccurrences(near.Right, postingsByToken, docId)
+	count := uint(0)
+	for _, left := range lefts {
+		for _, right := range rights {
+			if max(left, right)-min(left, right) <= near.Distance {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+const (
+	TermExplanation      string = "term"
+	ExpansionExplanation        = "expansion"
+	PhraseExplanation           = "phrase"
+	NearExplanation             = "near"
+)
+
+// Contribution of a single token of the query (or of a phrase or a proximity constraint, scored as a pseudo token)
+// towards the score of a document, Contribution = Boost * Score
+type TokenExplanation struct {
+	Token string `json:"token"`
+	// One of term, expansion, phrase or near
+	Kind string `json:"kind"`
+	// Token of the query expanded into this token, only set for the expansions
+	ExpansionOf string `json:"expansionOf,omitempty"`
+	// Word or phrase of the query this token is a synonym of, only set for the synonyms and their expansions
+	SynonymOf string `json:"synonymOf,omitempty"`
+	TermStats
+	ScoreDetails
+	Boost        float64 `json:"boost"`
+	Contribution float64 `json:"contribution"`
+}
+
+// Evaluates the query against the postings source. The boolean structure of the query decides which documents match,
+// the matching documents are then scored by the unique tokens of the query which are not excluded, additionally every
+// matching phrase and proximity constraint is scored as a pseudo token, boosted by PhraseBoost. The scores are
+// multiplied by the boosts of the queries contributing them. The contributions are recorded in the results when
+// explain is set
+func evaluate(source postingsSource, searchQuery SearchQuery, scorer Scorer, explain bool) ([]QueryResult, error) {
+	if searchQuery == nil {
+		return nil, nil
+	}
+	collector := newQueryCollector()
+	searchQuery.collect(collector, true)
+	if len(collector.scoring) == 0 {
+		return nil, nil
+	}
+	totalDocuments, avgDocLength, err := source.corpusStats()
+	if err != nil {
+		return nil, err
+	}
+	postingsByToken := map[string]map[string]posting{}
+	docLengths := map[string]uint{}
+	for token := range collector.tokens {
+		postings, err := source.postings(token, collector.positional[token])
+		if err != nil {
+			return nil, err
+		}
+		postingsByToken[token] = postings
+		for docId, p := range postings {
+			docLengths[docId] = p.docLength
+		}
+	}
+	matched, _ := searchQuery.match(postingsByToken)
+	scores := map[string]float64{}
+	explanations := map[string][]TokenExplanation{}
+	contribute := func(docId string, explanation TokenExplanation) {
+		details := scorer.Explain(explanation.TermStats)
+		explanation.ScoreDetails = details
+		explanation.Contribution = explanation.Boost * details.Score
+		scores[docId] += explanation.Contribution
+		if explain {
+			explanations[docId] = append(explanations[docId], explanation)
+		}
+	}
+	for _, boosted := range collector.scoring {
+		postings := postingsByToken[boosted.token]
+		kind := TermExplanation
+		if boosted.expansionOf != "" {
+			kind = ExpansionExplanation
+		}
+		for docId, p := range postings {
+			if !matched[docId] {
+				continue
+			}
+			contribute(docId, TokenExplanation{
+				Token:       boosted.token,
+				Kind:        kind,
+				ExpansionOf: boosted.expansionOf,
+				SynonymOf:   boosted.synonymOf,
+				TermStats: TermStats{
+					Frequency:      p.frequency,
+					DocFrequency:   uint(len(postings)),
+					TotalDocuments: totalDocuments,
+					DocLength:      p.docLength,
+					AvgDocLength:   avgDocLength,
+				},
+				Boost: boosted.boost,
+			})
+		}
+	}
+	boost := func(token string, kind string, synonymOf string, frequencies map[string]uint, boost float64) {
+		docFrequency := uint(len(frequencies))
+		for docId, frequency := range frequencies {
+			if !matched[docId] {
+				continue
+			}
+			contribute(docId, TokenExplanation{
+				Token:     token,
+				Kind:      kind,
+				SynonymOf: synonymOf,
+				TermStats: TermStats{
+					Frequency:      frequency,
+					DocFrequency:   docFrequency,
+					TotalDocuments: totalDocuments,
+					DocLength:      docLengths[docId],
+					AvgDocLength:   avgDocLength,
+				},
+				Boost: boostOrDefault(boost) * PhraseBoost,
+			})
+		}
+	}
+	for _, phrase := range collector.phrases {
+		boost(phrase.String(), PhraseExplanation, phrase.SynonymOf, phrase.frequencies(postingsByToken), phrase.Boost)
+	}
+	for _, near := range collector.nears {
+		boost(near.String(), NearExplanation, "", near.frequencies(postingsByToken), near.Boost)
+	}
+	// the matching documents are kept even when their score is zero, e.g. when a filter (+lang:en) matches all of them
+	ret := []QueryResult{}
+	for docId := range matched {
+		ret = append(ret, QueryResult{DocID: docId, Score: scores[docId], Explanation: explanations[docId]})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Score != ret[j].Score {
+			return ret[i].Score > ret[j].Score
+		}
+		return ret[i].DocID < ret[j].DocID
+	})
+	return ret, nil
+}
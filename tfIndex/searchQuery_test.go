@@ -0,0 +1,128 @@
+package tfIndex
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// Documents of the tests keyed by docId, their tokens are separated by spaces and positioned by their indexes
+var testDocs = map[string]string{
+	"a": "raft leader election timeout",
+	"b": "leader raft election",
+	"c": "connection pool timeout reached connection pool size",
+	"d": "pool connection title:raft",
+}
+
+// Returns the test documents indexed by each backend, keyed by the name of the backend
+func testIndexes(t *testing.T) map[string]TFIndex {
+	t.Helper()
+	sqliteTFIndex := NewSQLiteTFIndex(filepath.Join(t.TempDir(), "index.db"))
+	t.Cleanup(func() { sqliteTFIndex.Close() })
+	if err := sqliteTFIndex.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+	ret := map[string]TFIndex{"SimpleTFINdex": NewSimpleTFIndex(), "SQLiteTFIndex": sqliteTFIndex}
+	for _, index := range ret {
+		for docId, text := range testDocs {
+			tokens := strings.Fields(text)
+			if err := index.Update(docId, tokens, uint(len(tokens))); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return ret
+}
+
+// Returns the phrase of the consecutive tokens
+func phrase(tokens ...string) PhraseQuery {
+	positions := make([]uint, len(tokens))
+	for i := range tokens {
+		positions[i] = uint(i)
+	}
+	return PhraseQuery{Tokens: tokens, Positions: positions}
+}
+
+type matchCase struct {
+	query SearchQuery
+	// Sorted docIds of the documents exactly matching the query
+	docIds []string
+}
+
+// Checks that the documents exactly matching every query, i.e. the results of the query when it is required, are the
+// expected ones in both the backends
+func testMatches(t *testing.T, cases []matchCase) {
+	t.Helper()
+	for name, index := range testIndexes(t) {
+		for _, c := range cases {
+			results, err := index.Query(BooleanQuery{Required: []SearchQuery{c.query}}, TFIDFScorer{})
+			if err != nil {
+				t.Errorf("%s: Query(%v) failed: %s", name, c.query, err)
+				continue
+			}
+			got := []string{}
+			for _, result := range results {
+				got = append(got, result.DocID)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, c.docIds) {
+				t.Errorf("%s: Query(%v) = %v, want %v", name, c.query, got, c.docIds)
+			}
+		}
+	}
+}
+
+var phraseMatches = []matchCase{
+	{phrase("raft", "leader"), []string{"a"}},
+	{phrase("leader", "raft"), []string{"b"}},
+	{phrase("raft", "election"), []string{"b"}},
+	{phrase("election", "raft"), []string{}},
+	{phrase("raft", "leader", "election", "timeout"), []string{"a"}},
+	{phrase("connection", "pool"), []string{"c"}},
+	{phrase("pool", "connection"), []string{"d"}},
+	{PhraseQuery{Tokens: []string{"raft", "election"}, Positions: []uint{0, 2}}, []string{"a"}},
+	{BooleanQuery{Required: []SearchQuery{TermQuery{Token: "raft"}}, Excluded: []SearchQuery{phrase("raft", "leader")}}, []string{"b"}},
+}
+
+var nearMatches = []matchCase{
+	{NearQuery{Left: phrase("raft"), Right: phrase("election"), Distance: 1}, []string{"b"}},
+	{NearQuery{Left: phrase("raft"), Right: phrase("election"), Distance: 2}, []string{"a", "b"}},
+	{NearQuery{Left: phrase("timeout"), Right: phrase("leader"), Distance: 2}, []string{"a"}},
+	{NearQuery{Left: phrase("timeout"), Right: phrase("leader"), Distance: 1}, []string{}},
+	{NearQuery{Left: phrase("leader", "election"), Right: phrase("timeout"), Distance: 2}, []string{"a"}},
+	{NearQuery{Left: phrase("connection", "pool"), Right: phrase("size"), Distance: 2}, []string{"c"}},
+	{NearQuery{Left: phrase("pool"), Right: phrase("raft"), Distance: 5}, []string{}},
+}
+
+func TestPhraseQuery(t *testing.T) {
+	testMatches(t, phraseMatches)
+}
+
+func TestNearQuery(t *testing.T) {
+	testMatches(t, nearMatches)
+}
+
+// Returns the term queries of the tokens
+func terms(tokens ...string) []SearchQuery {
+	ret := make([]SearchQuery, len(tokens))
+	for i, token := range tokens {
+		ret[i] = TermQuery{Token: token}
+	}
+	return ret
+}
+
+var booleanMatches = []matchCase{
+	{BooleanQuery{Optional: terms("raft", "pool")}, []string{"a", "b", "c", "d"}},
+	{BooleanQuery{Required: terms("raft", "timeout")}, []string{"a"}},
+	{BooleanQuery{Required: terms("connection"), Excluded: terms("timeout")}, []string{"d"}},
+	{BooleanQuery{Optional: terms("raft", "pool"), Excluded: terms("leader", "size")}, []string{"d"}},
+	{BooleanQuery{Optional: terms("raft"), Required: terms("pool")}, []string{"c", "d"}},
+	{BooleanQuery{Required: []SearchQuery{BooleanQuery{Optional: terms("raft", "size")}, TermQuery{Token: "timeout"}}}, []string{"a", "c"}},
+	{BooleanQuery{Required: terms("title:raft")}, []string{"d"}},
+	{BooleanQuery{Required: terms("missing")}, []string{}},
+}
+
+func TestBooleanQuery(t *testing.T) {
+	testMatches(t, booleanMatches)
+}
@@ -2,47 +2,225 @@ package tfIndex
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"math"
 	"os"
 	"sort"
+	"strings"
 )
 
 type SimpleTFINdex struct {
-	index map[string]map[string]uint
+	index      map[string]map[string]uint
+	positions  map[string]map[string][]uint
+	docLengths map[string]uint
+	docMetas   map[string]DocMeta
+	metadata   map[string]string
+	// Distinct words of each document, see DocTokens.Words
+	docWords map[string][]string
+	// Number of the documents containing each token, kept along with the index rather than serialized
+	docFrequencies map[string]uint
+	// Number of the documents containing each word, kept along with docWords rather than serialized
+	wordFrequencies map[string]uint
+	// Dictionary of the tokens, see termDictionary
+	sorted *sortedTokens
+	// Sorted words of the documents, completing the partially typed words
+	sortedWords *sortedTokens
+}
+
+// Error of loading an index of a legacy format, e.g. predating the positions of the tokens, which has to be rebuilt
+var ErrLegacyFormat = errors.New("index is of a legacy format")
+
+// Version of the JSON format of SimpleTFINdex written by ToJSON, the indexes without it are of a legacy format
+const jsonFormatVersion = 1
+
+type simpleTFIndexJSON struct {
+	Version    int                          `json:"version"`
+	Index      map[string]map[string]uint   `json:"index"`
+	Positions  map[string]map[string][]uint `json:"positions"`
+	DocLengths map[string]uint              `json:"docLengths"`
+	Documents  map[string]DocMeta           `json:"documents"`
+	Words      map[string][]string          `json:"words"`
+	Metadata   map[string]string            `json:"metadata"`
 }
 
 func NewSimpleTFIndex() *SimpleTFINdex {
-	return &SimpleTFINdex{index: map[string]map[string]uint{}}
+	return &SimpleTFINdex{
+		index:           map[string]map[string]uint{},
+		positions:       map[string]map[string][]uint{},
+		docLengths:      map[string]uint{},
+		docMetas:        map[string]DocMeta{},
+		metadata:        map[string]string{},
+		docWords:        map[string][]string{},
+		docFrequencies:  map[string]uint{},
+		wordFrequencies: map[string]uint{},
+		sorted:          &sortedTokens{},
+		sortedWords:     &sortedTokens{},
+	}
 }
 
-func (simpleTFIndex *SimpleTFINdex) Update(docId string, tokens []string) error {
-	freqMap, ok := simpleTFIndex.index[docId]
-	if !ok {
-		freqMap = map[string]uint{}
-		simpleTFIndex.index[docId] = freqMap
+// Counts a document towards the document frequency of the token, or discounts it when removed is set
+func countDoc(docFrequencies map[string]uint, token string, removed bool) {
+	if !removed {
+		docFrequencies[token]++
+	} else if docFrequencies[token] <= 1 {
+		delete(docFrequencies, token)
+	} else {
+		docFrequencies[token]--
 	}
-	tf := TermFrequency(tokens)
-	for token, freq := range tf {
-		freqMap[token] = freq
+}
+
+// Counts the tokens and the words of the document towards the document frequencies, or discounts them when removed is
+// set
+func (simpleTFIndex *SimpleTFINdex) countDocFrequencies(docId string, removed bool) {
+	for token := range simpleTFIndex.index[docId] {
+		countDoc(simpleTFIndex.docFrequencies, token, removed)
+	}
+	for _, word := range simpleTFIndex.docWords[docId] {
+		countDoc(simpleTFIndex.wordFrequencies, word, removed)
 	}
+}
+
+// Replaces the document with the given tokens, dropping any tokens it was previously indexed with
+func (simpleTFIndex *SimpleTFINdex) update(docTokens DocTokens) {
+	docId := docTokens.DocID
+	simpleTFIndex.countDocFrequencies(docId, true)
+	simpleTFIndex.index[docId] = TermFrequency(docTokens.Tokens)
+	simpleTFIndex.docWords[docId] = docTokens.Words
+	simpleTFIndex.countDocFrequencies(docId, false)
+	simpleTFIndex.positions[docId] = TermPositions(docTokens.Tokens, docTokens.TokenPositions())
+	simpleTFIndex.docLengths[docId] = docTokens.Length
+	simpleTFIndex.docMetas[docId] = docTokens.Meta
+	simpleTFIndex.sorted.invalidate()
+	simpleTFIndex.sortedWords.invalidate()
+}
+
+func (simpleTFIndex *SimpleTFINdex) Update(docId string, tokens []string, length uint) error {
+	simpleTFIndex.update(DocTokens{DocID: docId, Tokens: tokens, Length: length})
 	return nil
 }
 
 func (simpleTFIndex *SimpleTFINdex) BulkUpdate(docTokens map[string][]string) error {
 	for docId, tokens := range docTokens {
-		simpleTFIndex.Update(docId, tokens)
+		simpleTFIndex.Update(docId, tokens, uint(len(tokens)))
 	}
 	return nil
 }
 
 func (simpleTFINdex *SimpleTFINdex) BulkUpdateChan(docTokensCH <-chan DocTokens) error {
 	for docToken := range docTokensCH {
-		simpleTFINdex.Update(docToken.DocID, docToken.Tokens)
+		simpleTFINdex.update(docToken)
+	}
+	return nil
+}
+
+func (simpleTFIndex *SimpleTFINdex) Delete(docId string) error {
+	simpleTFIndex.countDocFrequencies(docId, true)
+	delete(simpleTFIndex.index, docId)
+	delete(simpleTFIndex.positions, docId)
+	delete(simpleTFIndex.docLengths, docId)
+	delete(simpleTFIndex.docMetas, docId)
+	delete(simpleTFIndex.docWords, docId)
+	simpleTFIndex.sorted.invalidate()
+	simpleTFIndex.sortedWords.invalidate()
+	return nil
+}
+
+func (simpleTFIndex *SimpleTFINdex) BulkDelete(docIds []string) error {
+	for _, docId := range docIds {
+		simpleTFIndex.Delete(docId)
+	}
+	return nil
+}
+
+func (simpleTFIndex SimpleTFINdex) Documents() (map[string]DocMeta, error) {
+	ret := map[string]DocMeta{}
+	for docId := range simpleTFIndex.index {
+		ret[docId] = simpleTFIndex.docMetas[docId]
+	}
+	return ret, nil
+}
+
+func (simpleTFIndex SimpleTFINdex) Document(docId string) (DocMeta, bool, error) {
+	if _, ok := simpleTFIndex.index[docId]; !ok {
+		return DocMeta{}, false, nil
+	}
+	return simpleTFIndex.docMetas[docId], true, nil
+}
+
+func (simpleTFIndex SimpleTFINdex) IsEmpty() (bool, error) {
+	return len(simpleTFIndex.index) == 0, nil
+}
+
+func (simpleTFIndex *SimpleTFINdex) Clear() error {
+	simpleTFIndex.index = map[string]map[string]uint{}
+	simpleTFIndex.positions = map[string]map[string][]uint{}
+	simpleTFIndex.docLengths = map[string]uint{}
+	simpleTFIndex.docMetas = map[string]DocMeta{}
+	simpleTFIndex.docWords = map[string][]string{}
+	simpleTFIndex.docFrequencies = map[string]uint{}
+	simpleTFIndex.wordFrequencies = map[string]uint{}
+	simpleTFIndex.sorted.invalidate()
+	simpleTFIndex.sortedWords.invalidate()
+	return nil
+}
+
+func (simpleTFIndex *SimpleTFINdex) UpdateMetas(metas map[string]DocMeta) error {
+	for docId, meta := range metas {
+		if _, ok := simpleTFIndex.index[docId]; ok {
+			simpleTFIndex.docMetas[docId] = meta
+		}
+	}
+	return nil
+}
+
+func (simpleTFIndex SimpleTFINdex) Vocabulary(prefix string) (map[string]uint, error) {
+	ret := map[string]uint{}
+	tokens := simpleTFIndex.sorted.get(simpleTFIndex.docFrequencies)
+	for i := sort.SearchStrings(tokens, prefix); i < len(tokens) && strings.HasPrefix(tokens[i], prefix); i++ {
+		ret[tokens[i]] = simpleTFIndex.DF(tokens[i])
+	}
+	return ret, nil
+}
+
+func (simpleTFIndex SimpleTFINdex) Words(prefix string) (map[string]uint, error) {
+	ret := map[string]uint{}
+	words := simpleTFIndex.sortedWords.get(simpleTFIndex.wordFrequencies)
+	for i := sort.SearchStrings(words, prefix); i < len(words) && strings.HasPrefix(words[i], prefix); i++ {
+		ret[words[i]] = simpleTFIndex.wordFrequencies[words[i]]
+	}
+	return ret, nil
+}
+
+func (simpleTFIndex SimpleTFINdex) DocFrequency(token string) (uint, error) {
+	return simpleTFIndex.DF(token), nil
+}
+
+func (simpleTFIndex SimpleTFINdex) seek(from string) (string, bool, error) {
+	tokens := simpleTFIndex.sorted.get(simpleTFIndex.docFrequencies)
+	if i := sort.SearchStrings(tokens, from); i < len(tokens) {
+		return tokens[i], true, nil
+	}
+	return "", false, nil
+}
+
+func (simpleTFIndex SimpleTFINdex) scan(prefix string, visit func(token string) bool) error {
+	tokens := simpleTFIndex.sorted.get(simpleTFIndex.docFrequencies)
+	for i := sort.SearchStrings(tokens, prefix); i < len(tokens) && strings.HasPrefix(tokens[i], prefix); i++ {
+		if !visit(tokens[i]) {
+			break
+		}
 	}
 	return nil
 }
 
+func (simpleTFIndex SimpleTFINdex) WildcardTokens(prefix string, pattern string, limit uint) ([]string, error) {
+	return wildcardTokens(simpleTFIndex, prefix, pattern, limit)
+}
+
+func (simpleTFIndex SimpleTFINdex) FuzzyTokens(prefix string, token string, maxDistance uint) (map[string]uint, error) {
+	return fuzzyTokens(simpleTFIndex, prefix, token, maxDistance)
+}
+
 func (simpleTFINdex SimpleTFINdex) TF(docId string, token string) uint {
 	freqMap, ok := simpleTFINdex.index[docId]
 	if !ok {
@@ -52,53 +230,91 @@ func (simpleTFINdex SimpleTFINdex) TF(docId string, token string) uint {
 }
 
 func (simpleTFINdex SimpleTFINdex) DF(token string) uint {
-	df := uint(0)
-	for _, freqMap := range simpleTFINdex.index {
-		if _, ok := freqMap[token]; ok {
-			df++
-		}
-	}
-	return df
+	return simpleTFINdex.docFrequencies[token]
 }
 
 func (simpleTFINdex SimpleTFINdex) IDF(token string) float64 {
-	numer := len(simpleTFINdex.index)
-	denom := simpleTFINdex.DF(token)
-	return math.Log(float64(numer) / float64(denom))
+	return TFIDFScorer{}.IDF(simpleTFINdex.DF(token), uint(len(simpleTFINdex.index)))
 }
 
-func (simpleTFIndex SimpleTFINdex) Query(tokens []string) ([]QueryResult, error) {
-	if len(tokens) == 0 {
-		return nil, nil
+func (simpleTFINdex SimpleTFINdex) AvgDocLength() float64 {
+	if len(simpleTFINdex.docLengths) == 0 {
+		return 0.0
 	}
-	idfs := map[string]float64{}
-	for _, token := range tokens {
-		if _, ok := idfs[token]; !ok {
-			idfs[token] = simpleTFIndex.IDF(token)
-		}
+	total := uint(0)
+	for _, docLength := range simpleTFINdex.docLengths {
+		total += docLength
 	}
-	ret := []QueryResult{}
-	for docId := range simpleTFIndex.index {
-		tfIdf := 0.0
-		for token, idf := range idfs {
-			tf := simpleTFIndex.TF(docId, token)
-			tfIdf += float64(tf) * idf
-		}
-		if tfIdf > 0.0 {
-			ret = append(ret, QueryResult{DocID: docId, Score: tfIdf})
+	return float64(total) / float64(len(simpleTFINdex.docLengths))
+}
+
+func (simpleTFINdex SimpleTFINdex) corpusStats() (uint, float64, error) {
+	return uint(len(simpleTFINdex.index)), simpleTFINdex.AvgDocLength(), nil
+}
+
+func (simpleTFINdex SimpleTFINdex) postings(token string, withPositions bool) (map[string]posting, error) {
+	ret := map[string]posting{}
+	for docId, freqMap := range simpleTFINdex.index {
+		if freq, ok := freqMap[token]; ok {
+			p := posting{frequency: freq, docLength: simpleTFINdex.docLengths[docId]}
+			if withPositions {
+				p.positions = simpleTFINdex.positions[docId][token]
+			}
+			ret[docId] = p
 		}
 	}
-	sort.Slice(ret, func(i, j int) bool { return ret[i].Score > ret[j].Score })
 	return ret, nil
 }
 
-func (simpleTFIndex SimpleTFINdex) QueryTopN(tokens []string, topN uint) ([]QueryResult, error) {
-	results, err := simpleTFIndex.Query(tokens)
+func (simpleTFIndex SimpleTFINdex) Query(searchQuery SearchQuery, scorer Scorer) ([]QueryResult, error) {
+	searchQuery, err := resolveExpansions(simpleTFIndex, searchQuery)
+	if err != nil {
+		return nil, err
+	}
+	return evaluate(simpleTFIndex, searchQuery, scorer, false)
+}
+
+func (simpleTFIndex SimpleTFINdex) QueryTopN(searchQuery SearchQuery, topN uint, scorer Scorer, explain bool) ([]QueryResult, error) {
+	searchQuery, err := resolveExpansions(simpleTFIndex, searchQuery)
+	if err != nil {
+		return nil, err
+	}
+	results, err := evaluate(simpleTFIndex, searchQuery, scorer, explain)
 	return results[:min(topN, uint(len(results)))], err
 }
 
+func (simpleTFIndex SimpleTFINdex) QueryPage(searchQuery SearchQuery, offset uint, limit uint, scorer Scorer, explain bool) (ResultPage, error) {
+	searchQuery, err := resolveExpansions(simpleTFIndex, searchQuery)
+	if err != nil {
+		return ResultPage{}, err
+	}
+	results, err := evaluate(simpleTFIndex, searchQuery, scorer, explain)
+	if err != nil {
+		return ResultPage{}, err
+	}
+	return pageOf(results, offset, limit), nil
+}
+
+func (simpleTFIndex SimpleTFINdex) Metadata(key string) (string, bool, error) {
+	value, ok := simpleTFIndex.metadata[key]
+	return value, ok, nil
+}
+
+func (simpleTFIndex *SimpleTFINdex) SetMetadata(key string, value string) error {
+	simpleTFIndex.metadata[key] = value
+	return nil
+}
+
 func (simpleTFINdex SimpleTFINdex) ToJSON() ([]byte, error) {
-	bytes, err := json.Marshal(simpleTFINdex.index)
+	bytes, err := json.Marshal(simpleTFIndexJSON{
+		Version:    jsonFormatVersion,
+		Index:      simpleTFINdex.index,
+		Positions:  simpleTFINdex.positions,
+		DocLengths: simpleTFINdex.docLengths,
+		Documents:  simpleTFINdex.docMetas,
+		Words:      simpleTFINdex.docWords,
+		Metadata:   simpleTFINdex.metadata,
+	})
 	if err != nil {
 		return bytes, fmt.Errorf("SimpleTFINdex.ToJSON: cannot convert to JSON: %w", err)
 	}
@@ -110,12 +326,7 @@ func (simpleTFIndex SimpleTFINdex) DumpToJSON(jsonPath string) error {
 	if err != nil {
 		return fmt.Errorf("simpleTFIndex.DumpToJSON %w", err)
 	}
-	fd, err := os.Create(jsonPath)
-	if err != nil {
-		return fmt.Errorf("simpleTFIndex.DumpToJSON %w", err)
-	}
-	_, err = fd.Write(bytes)
-	if err != nil {
+	if err := os.WriteFile(jsonPath, bytes, 0666); err != nil {
 		return fmt.Errorf("simpleTFIndex.DumpToJSON %w", err)
 	}
 	return nil
@@ -126,10 +337,43 @@ func SimpleTFINdexFromJSON(jsonPath string) (*SimpleTFINdex, error) {
 	if err != nil {
 		return nil, fmt.Errorf("SimpleTFINdexFromJSON: cannot read the file `%s`: %w", jsonPath, err)
 	}
-	ret := SimpleTFINdex{}
-	err = json.Unmarshal(bytes, &ret.index)
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(bytes, &fields)
 	if err != nil {
 		return nil, fmt.Errorf("SimpleTFINdexFromJSON: cannot convert from JSON: %w", err)
 	}
-	return &ret, nil
+	// the indexes built before the positions were recorded are bare maps of the term frequencies keyed by docId, where
+	// a document named `version` maps to its term frequencies rather than to a number
+	var version int
+	if err := json.Unmarshal(fields["version"], &version); err != nil || version != jsonFormatVersion {
+		return nil, fmt.Errorf("SimpleTFINdexFromJSON: cannot load `%s`: %w", jsonPath, ErrLegacyFormat)
+	}
+	var indexJSON simpleTFIndexJSON
+	err = json.Unmarshal(bytes, &indexJSON)
+	if err != nil {
+		return nil, fmt.Errorf("SimpleTFINdexFromJSON: cannot convert from JSON: %w", err)
+	}
+	ret := NewSimpleTFIndex()
+	if indexJSON.Index != nil {
+		ret.index = indexJSON.Index
+	}
+	if indexJSON.Words != nil {
+		ret.docWords = indexJSON.Words
+	}
+	for docId := range ret.index {
+		ret.countDocFrequencies(docId, false)
+	}
+	if indexJSON.Positions != nil {
+		ret.positions = indexJSON.Positions
+	}
+	if indexJSON.DocLengths != nil {
+		ret.docLengths = indexJSON.DocLengths
+	}
+	if indexJSON.Documents != nil {
+		ret.docMetas = indexJSON.Documents
+	}
+	if indexJSON.Metadata != nil {
+		ret.metadata = indexJSON.Metadata
+	}
+	return ret, nil
 }
@@ -20,8 +20,11 @@ func NewSQLiteTFIndex(dbPath string) *SQLiteTFIndex {
 	return &SQLiteTFIndex{dbPath: dbPath, db: nil}
 }
 
-func (sqliteTFIndex *SQLiteTFIndex) Update(docId string, tokens []string) error {
-	return sqliteTFIndex.BulkUpdate(map[string][]string{docId: tokens})
+func (sqliteTFIndex *SQLiteTFIndex) Update(docId string, tokens []string, length uint) error {
+	docTokensCh := make(chan DocTokens, 1)
+	docTokensCh <- DocTokens{DocID: docId, Tokens: tokens, Length: length}
+	close(docTokensCh)
+	return sqliteTFIndex.BulkUpdateChan(docTokensCh)
 }
 
 func (sqliteTFIndex *SQLiteTFIndex) Connect() (*sql.DB, error) {
@@ -58,29 +61,36 @@ func (sqliteTFIndex *SQLiteTFIndex) Begin() (*sql.Tx, error) {
 	return tx, nil
 }
 
-func (sqliteTFIndex *SQLiteTFIndex) BulkUpdateChan(docTokensCH <-chan DocTokens) error {
+// Creates the tables of the index and adds the columns missing from the tables of the indexes built by the earlier
+// versions. The index is migrated once before it is built, the other methods never change its schema
+func (sqliteTFIndex *SQLiteTFIndex) Migrate() error {
 	db, err := sqliteTFIndex.Connect()
 	if err != nil {
 		return err
 	}
-	// Set some PRAGMA options for optimization
-	_, err = db.Exec("PRAGMA synchronous = OFF")
-	if err != nil {
-		return fmt.Errorf("SQLiteTFIndex.BulkUpdateChan cannot set PRAGMA synchronous = OFF: %w", err)
-	}
-	_, err = db.Exec("PRAGMA journal_mode = MEMORY")
-	if err != nil {
-		return fmt.Errorf("SQLiteTFIndex.BulkUpdateChan cannot set PRAGMA journal_mode = MEMORY: %w", err)
-	}
-	tx, err := sqliteTFIndex.Begin()
+	return sqliteTFIndex.createSchema(db)
+}
+
+// Checks if the index has the table, the indexes which are not migrated may lack some of the tables, see Migrate
+func (sqliteTFIndex *SQLiteTFIndex) hasTable(db *sql.DB, table string) (bool, error) {
+	tables := 0
+	err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&tables)
 	if err != nil {
-		return err
+		return false, fmt.Errorf("SQLiteTFIndex.hasTable cannot look up the table %s: %w", table, err)
 	}
-	_, err = tx.Exec(`
+	return tables > 0, nil
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) createSchema(execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	QueryRow(query string, args ...any) *sql.Row
+}) error {
+	_, err := execer.Exec(`
         CREATE TABLE IF NOT EXISTS termFrequenciesIndex (
             filePath            STRING  NOT NULL,
             token               TEXT    NOT NULL,
             frequency           INTEGER,
+            positions           TEXT,
             docFrequency        INTEGER,
             totalDocuments      INTEGER,
             inverseDocFrequency REAL
@@ -88,17 +98,215 @@ func (sqliteTFIndex *SQLiteTFIndex) BulkUpdateChan(docTokensCH <-chan DocTokens)
         CREATE UNIQUE INDEX IF NOT EXISTS ux_filePath_token ON termFrequenciesIndex(filePath, token);
         CREATE INDEX        IF NOT EXISTS ix_filePath       ON termFrequenciesIndex(filePath);
         CREATE INDEX        IF NOT EXISTS ix_token          ON termFrequenciesIndex(token);
+        CREATE TABLE IF NOT EXISTS documents (
+            filePath            TEXT    NOT NULL PRIMARY KEY,
+            length              INTEGER NOT NULL,
+            size                INTEGER,
+            modTime             INTEGER,
+            hash                TEXT,
+            title               TEXT,
+            language            TEXT
+        );
+        CREATE TABLE IF NOT EXISTS words (
+            filePath            TEXT    NOT NULL,
+            word                TEXT    NOT NULL
+        );
+        CREATE UNIQUE INDEX IF NOT EXISTS ux_word_filePath  ON words(word, filePath);
+        CREATE INDEX        IF NOT EXISTS ix_words_filePath ON words(filePath);
+        CREATE TABLE IF NOT EXISTS metadata (
+            key                 TEXT    NOT NULL PRIMARY KEY,
+            value
+        );
     `)
 	if err != nil {
-		return fmt.Errorf("SQLiteTFIndex.BulkUpdate cannot create the table: %w", err)
+		return fmt.Errorf("SQLiteTFIndex.createSchema cannot create the tables: %w", err)
+	}
+	// the tables of the indexes built before the positions, the languages and the titles were recorded lack their columns
+	if err := addMissingColumn(execer, "termFrequenciesIndex", "positions", "TEXT"); err != nil {
+		return err
+	}
+	if err := addMissingColumn(execer, "documents", "language", "TEXT"); err != nil {
+		return err
+	}
+	return addMissingColumn(execer, "documents", "title", "TEXT")
+}
+
+// Adds the column to the table unless the table already has it
+func addMissingColumn(execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	QueryRow(query string, args ...any) *sql.Row
+}, table string, column string, columnType string) error {
+	var columns int
+	err := execer.QueryRow("SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?", table, column).Scan(&columns)
+	if err != nil {
+		return fmt.Errorf("SQLiteTFIndex.createSchema cannot read the columns of the table %s: %w", table, err)
+	}
+	if columns > 0 {
+		return nil
+	}
+	if _, err := execer.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, columnType)); err != nil {
+		return fmt.Errorf("SQLiteTFIndex.createSchema cannot add the column %s to the table %s: %w", column, table, err)
+	}
+	return nil
+}
+
+// Refreshes docFrequency and inverseDocFrequency of the affected tokens. The inverseDocFrequency of all the tokens
+// is refreshed only when the total number of documents has changed
+func (sqliteTFIndex *SQLiteTFIndex) refreshStats(tx *sql.Tx, affectedTokens map[string]bool) error {
+	_, err := tx.Exec(`
+        CREATE TEMP TABLE IF NOT EXISTS affectedTokens (
+            token               TEXT    NOT NULL PRIMARY KEY
+        );
+        DELETE FROM affectedTokens;
+    `)
+	if err != nil {
+		return fmt.Errorf("SQLiteTFIndex.refreshStats cannot create the table affectedTokens: %w", err)
 	}
 	var valueStrings []string
 	var valueArgs []any
+	flushToDB := func() error {
+		stmt := "INSERT INTO affectedTokens (token) VALUES " + strings.Join(valueStrings, ",")
+		_, err := tx.Exec(stmt, valueArgs...)
+		if err != nil {
+			return fmt.Errorf("SQLiteTFIndex.refreshStats cannot execute the statement `%s`: %w", stmt, err)
+		}
+		valueStrings = nil
+		valueArgs = nil
+		return nil
+	}
+	for token := range affectedTokens {
+		valueStrings = append(valueStrings, "(?)")
+		valueArgs = append(valueArgs, token)
+		if len(valueStrings) == BatchSize {
+			if err := flushToDB(); err != nil {
+				return err
+			}
+		}
+	}
+	if len(valueStrings) > 0 {
+		if err := flushToDB(); err != nil {
+			return err
+		}
+	}
+	previousTotalDocuments, totalDocuments := 0, 0
+	err = tx.QueryRow("SELECT COALESCE((SELECT value FROM metadata WHERE key = 'totalDocuments'), 0)").Scan(&previousTotalDocuments)
+	if err != nil {
+		return fmt.Errorf("SQLiteTFIndex.refreshStats cannot read the previous total number of documents: %w", err)
+	}
+	err = tx.QueryRow("SELECT COUNT(*) FROM documents").Scan(&totalDocuments)
+	if err != nil {
+		return fmt.Errorf("SQLiteTFIndex.refreshStats cannot count the documents: %w", err)
+	}
+	refreshIDFs := `
+        UPDATE termFrequenciesIndex
+        SET
+            totalDocuments = ?,
+            inverseDocFrequency = LN(CAST(? AS REAL) / docFrequency)
+    `
+	if totalDocuments == previousTotalDocuments {
+		refreshIDFs += `
+        WHERE token IN (SELECT token FROM affectedTokens)
+        `
+	}
+	updateStats := []struct {
+		stmt string
+		args []any
+	}{
+		{
+			stmt: `
+                UPDATE termFrequenciesIndex
+                SET
+                    docFrequency = (
+                        SELECT COUNT(*)
+                        FROM termFrequenciesIndex t
+                        WHERE t.token = termFrequenciesIndex.token
+                    )
+                WHERE token IN (SELECT token FROM affectedTokens)
+            `,
+		},
+		{stmt: refreshIDFs, args: []any{totalDocuments, totalDocuments}},
+		{
+			stmt: `
+                INSERT INTO metadata (key, value) VALUES ('totalDocuments', ?)
+                ON CONFLICT(key) DO UPDATE SET
+                    value = excluded.value
+            `,
+			args: []any{totalDocuments},
+		},
+		{
+			stmt: `
+                INSERT INTO metadata (key, value)
+                SELECT 'avgDocLength', COALESCE(AVG(length), 0.0) FROM documents WHERE true
+                ON CONFLICT(key) DO UPDATE SET
+                    value = excluded.value
+            `,
+		},
+		{stmt: "DROP TABLE affectedTokens"},
+	}
+	for _, updateStat := range updateStats {
+		_, err = tx.Exec(updateStat.stmt, updateStat.args...)
+		if err != nil {
+			return fmt.Errorf("SQLiteTFIndex.refreshStats cannot refresh the stats using the query `%s`: %w", updateStat.stmt, err)
+		}
+	}
+	return nil
+}
+
+// Deletes the document within the transaction, recording its tokens as affected
+func (sqliteTFIndex *SQLiteTFIndex) deleteDocument(tx *sql.Tx, docId string, affectedTokens map[string]bool) error {
+	rows, err := tx.Query("SELECT token FROM termFrequenciesIndex WHERE filePath = ?", docId)
+	if err != nil {
+		return fmt.Errorf("SQLiteTFIndex.deleteDocument cannot read the tokens of the document `%s`: %w", docId, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		token := ""
+		if err := rows.Scan(&token); err != nil {
+			return fmt.Errorf("SQLiteTFIndex.deleteDocument could not parse the token of the document `%s`: %w", docId, err)
+		}
+		affectedTokens[token] = true
+	}
+	rows.Close()
+	for _, stmt := range []string{
+		"DELETE FROM termFrequenciesIndex WHERE filePath = ?",
+		"DELETE FROM words WHERE filePath = ?",
+		"DELETE FROM documents WHERE filePath = ?",
+	} {
+		_, err = tx.Exec(stmt, docId)
+		if err != nil {
+			return fmt.Errorf("SQLiteTFIndex.deleteDocument cannot delete the document `%s`: %w", docId, err)
+		}
+	}
+	return nil
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) BulkUpdateChan(docTokensCH <-chan DocTokens) error {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return err
+	}
+	// Set some PRAGMA options for optimization
+	_, err = db.Exec("PRAGMA synchronous = OFF")
+	if err != nil {
+		return fmt.Errorf("SQLiteTFIndex.BulkUpdateChan cannot set PRAGMA synchronous = OFF: %w", err)
+	}
+	_, err = db.Exec("PRAGMA journal_mode = MEMORY")
+	if err != nil {
+		return fmt.Errorf("SQLiteTFIndex.BulkUpdateChan cannot set PRAGMA journal_mode = MEMORY: %w", err)
+	}
+	tx, err := sqliteTFIndex.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	var valueStrings []string
+	var valueArgs []any
 	flushToDB := func() error {
 		stmt := fmt.Sprintf(`
-            INSERT INTO termFrequenciesIndex (filePath, token, frequency) VALUES %s
+            INSERT INTO termFrequenciesIndex (filePath, token, frequency, positions) VALUES %s
             ON CONFLICT(filePath, token) DO UPDATE SET
-                frequency = excluded.frequency
+                frequency = excluded.frequency,
+                positions = excluded.positions
             `,
 			strings.Join(valueStrings, ","),
 		)
@@ -109,14 +317,67 @@ func (sqliteTFIndex *SQLiteTFIndex) BulkUpdateChan(docTokensCH <-chan DocTokens)
 		}
 		return nil
 	}
+	insertWord, err := tx.Prepare("INSERT OR IGNORE INTO words (filePath, word) VALUES (?, ?)")
+	if err != nil {
+		return fmt.Errorf("SQLiteTFIndex.BulkUpdate cannot prepare the statement inserting the words: %w", err)
+	}
+	defer insertWord.Close()
+	affectedTokens := map[string]bool{}
+	seenDocs := map[string]bool{}
 	for docToken := range docTokensCH {
-		filePath, tokens := docToken.DocID, docToken.Tokens
+		filePath, tokens, meta := docToken.DocID, docToken.Tokens, docToken.Meta
+		// Flush the pending rows when the same document is seen again, so that they are replaced as well
+		if seenDocs[filePath] && len(valueStrings) > 0 {
+			if err := flushToDB(); err != nil {
+				return err
+			}
+			valueStrings = nil
+			valueArgs = nil
+		}
+		seenDocs[filePath] = true
+		err = sqliteTFIndex.deleteDocument(tx, filePath, affectedTokens)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+            INSERT INTO documents (filePath, length, size, modTime, hash, title, language) VALUES (?, ?, ?, ?, ?, ?, ?)
+            ON CONFLICT(filePath) DO UPDATE SET
+                length = excluded.length,
+                size = excluded.size,
+                modTime = excluded.modTime,
+                hash = excluded.hash,
+                title = excluded.title,
+                language = excluded.language
+            `,
+			filePath,
+			docToken.Length,
+			meta.Size,
+			meta.ModTime,
+			meta.Hash,
+			meta.Title,
+			meta.Language,
+		)
+		if err != nil {
+			return fmt.Errorf("SQLiteTFIndex.BulkUpdate cannot update the document `%s`: %w", filePath, err)
+		}
+		for _, word := range docToken.Words {
+			if _, err := insertWord.Exec(filePath, word); err != nil {
+				return fmt.Errorf("SQLiteTFIndex.BulkUpdate cannot insert the word `%s` of the document `%s`: %w", word, filePath, err)
+			}
+		}
 		tf := TermFrequency(tokens)
+		termPositions := TermPositions(tokens, docToken.TokenPositions())
 		for term, freq := range tf {
-			valueStrings = append(valueStrings, "(?, ?, ?)")
+			positions, err := json.Marshal(termPositions[term])
+			if err != nil {
+				return fmt.Errorf("SQLiteTFIndex.BulkUpdate cannot convert the positions of the token `%s` to JSON: %w", term, err)
+			}
+			affectedTokens[term] = true
+			valueStrings = append(valueStrings, "(?, ?, ?, ?)")
 			valueArgs = append(valueArgs, filePath)
 			valueArgs = append(valueArgs, term)
 			valueArgs = append(valueArgs, freq)
+			valueArgs = append(valueArgs, string(positions))
 			if len(valueStrings) == BatchSize {
 				if err := flushToDB(); err != nil {
 					return err
@@ -131,38 +392,9 @@ func (sqliteTFIndex *SQLiteTFIndex) BulkUpdateChan(docTokensCH <-chan DocTokens)
 			return err
 		}
 	}
-	updateStats := `
-        WITH docFrequencyByToken AS (
-            SELECT
-                token,
-                COUNT(DISTINCT filePath) docFrequency
-            FROM termFrequenciesIndex
-            GROUP BY
-                token
-        )
-        UPDATE termFrequenciesIndex
-        SET
-            docFrequency = (
-                SELECT docFrequency
-                FROM docFrequencyByToken
-                WHERE token = termFrequenciesIndex.token
-            ),
-            totalDocuments = (
-                SELECT COUNT(DISTINCT filePath)
-                FROM termFrequenciesIndex
-            )
-        ;
-        UPDATE termFrequenciesIndex
-        SET
-            inverseDocFrequency = LN(totalDocuments / docFrequency)
-        ;
-        DELETE FROM termFrequenciesIndex
-        WHERE inverseDocFrequency = 0.0
-        ;
-    `
-	_, err = tx.Exec(updateStats)
+	err = sqliteTFIndex.refreshStats(tx, affectedTokens)
 	if err != nil {
-		return fmt.Errorf("SQLiteTFIndex.BulkUpdate cannot refresh the stats using the query `%s`: %w", updateStats, err)
+		return err
 	}
 	err = tx.Commit()
 	if err != nil {
@@ -171,44 +403,429 @@ func (sqliteTFIndex *SQLiteTFIndex) BulkUpdateChan(docTokensCH <-chan DocTokens)
 	return nil
 }
 
+func (sqliteTFIndex *SQLiteTFIndex) Delete(docId string) error {
+	return sqliteTFIndex.BulkDelete([]string{docId})
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) BulkDelete(docIds []string) error {
+	if len(docIds) == 0 {
+		return nil
+	}
+	tx, err := sqliteTFIndex.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	affectedTokens := map[string]bool{}
+	for _, docId := range docIds {
+		err = sqliteTFIndex.deleteDocument(tx, docId, affectedTokens)
+		if err != nil {
+			return err
+		}
+	}
+	err = sqliteTFIndex.refreshStats(tx, affectedTokens)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("SQLiteTFIndex.BulkDelete cannot commit the transaction: %w", err)
+	}
+	return nil
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) IsEmpty() (bool, error) {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return false, err
+	}
+	// the legacy indexes recorded their tokens without the table of the documents
+	if ok, err := sqliteTFIndex.hasTable(db, "documents"); err != nil || !ok {
+		return false, err
+	}
+	empty := false
+	err = db.QueryRow("SELECT NOT EXISTS (SELECT 1 FROM termFrequenciesIndex) AND NOT EXISTS (SELECT 1 FROM documents)").Scan(&empty)
+	if err != nil {
+		return false, fmt.Errorf("SQLiteTFIndex.IsEmpty cannot check for the documents and the tokens: %w", err)
+	}
+	return empty, nil
+}
+
+// The average document length is the only metadata derived from the documents, it is removed along with them
+func (sqliteTFIndex *SQLiteTFIndex) Clear() error {
+	tx, err := sqliteTFIndex.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, stmt := range []string{
+		"DELETE FROM termFrequenciesIndex",
+		"DELETE FROM words",
+		"DELETE FROM documents",
+		"DELETE FROM metadata WHERE key = 'avgDocLength'",
+	} {
+		_, err = tx.Exec(stmt)
+		if err != nil {
+			return fmt.Errorf("SQLiteTFIndex.Clear cannot run the statement `%s`: %w", stmt, err)
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("SQLiteTFIndex.Clear cannot commit the transaction: %w", err)
+	}
+	return nil
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) UpdateMetas(metas map[string]DocMeta) error {
+	if len(metas) == 0 {
+		return nil
+	}
+	tx, err := sqliteTFIndex.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for docId, meta := range metas {
+		_, err = tx.Exec(
+			"UPDATE documents SET size = ?, modTime = ?, hash = ?, title = ?, language = ? WHERE filePath = ?",
+			meta.Size,
+			meta.ModTime,
+			meta.Hash,
+			meta.Title,
+			meta.Language,
+			docId,
+		)
+		if err != nil {
+			return fmt.Errorf("SQLiteTFIndex.UpdateMetas cannot update the metadata of `%s`: %w", docId, err)
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("SQLiteTFIndex.UpdateMetas cannot commit the transaction: %w", err)
+	}
+	return nil
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) Documents() (map[string]DocMeta, error) {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.Query("SELECT filePath, " + docMetaColumns + " FROM documents")
+	if err != nil {
+		return nil, fmt.Errorf("SQLiteTFIndex.Documents cannot read the documents: %w", err)
+	}
+	defer rows.Close()
+	ret := map[string]DocMeta{}
+	for rows.Next() {
+		docId := ""
+		meta := DocMeta{}
+		err := rows.Scan(&docId, &meta.Size, &meta.ModTime, &meta.Hash, &meta.Title, &meta.Language)
+		if err != nil {
+			return nil, fmt.Errorf("SQLiteTFIndex.Documents could not parse the rows into DocMeta: %w", err)
+		}
+		ret[docId] = meta
+	}
+	return ret, nil
+}
+
+// Columns of the table of the documents scanned into DocMeta
+const docMetaColumns = "COALESCE(size, 0), COALESCE(modTime, 0), COALESCE(hash, ''), COALESCE(title, ''), COALESCE(language, '')"
+
+func (sqliteTFIndex *SQLiteTFIndex) Document(docId string) (DocMeta, bool, error) {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return DocMeta{}, false, err
+	}
+	meta := DocMeta{}
+	err = db.QueryRow("SELECT "+docMetaColumns+" FROM documents WHERE filePath = ?", docId).Scan(&meta.Size, &meta.ModTime, &meta.Hash, &meta.Title, &meta.Language)
+	if err == sql.ErrNoRows {
+		return DocMeta{}, false, nil
+	}
+	if err != nil {
+		return DocMeta{}, false, fmt.Errorf("SQLiteTFIndex.Document cannot read the document `%s`: %w", docId, err)
+	}
+	return meta, true, nil
+}
+
+// The tokens are matched by a range over ix_token rather than LIKE, which would be case insensitive and treat % and _
+// as wildcards
+func (sqliteTFIndex *SQLiteTFIndex) Vocabulary(prefix string) (map[string]uint, error) {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return nil, err
+	}
+	query := "SELECT token, COUNT(*) FROM termFrequenciesIndex WHERE token >= ? GROUP BY token"
+	args := []any{prefix}
+	if end, ok := successor(prefix); ok {
+		query = "SELECT token, COUNT(*) FROM termFrequenciesIndex WHERE token >= ? AND token < ? GROUP BY token"
+		args = append(args, end)
+	}
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SQLiteTFIndex.Vocabulary cannot read the tokens starting with `%s`: %w", prefix, err)
+	}
+	defer rows.Close()
+	ret := map[string]uint{}
+	for rows.Next() {
+		token := ""
+		docFrequency := uint(0)
+		if err := rows.Scan(&token, &docFrequency); err != nil {
+			return nil, fmt.Errorf("SQLiteTFIndex.Vocabulary could not parse the rows into tokens: %w", err)
+		}
+		ret[token] = docFrequency
+	}
+	return ret, nil
+}
+
+// The words are matched by a range over ux_word_filePath, see Vocabulary
+func (sqliteTFIndex *SQLiteTFIndex) Words(prefix string) (map[string]uint, error) {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return nil, err
+	}
+	query := "SELECT word, COUNT(*) FROM words WHERE word >= ? GROUP BY word"
+	args := []any{prefix}
+	if end, ok := successor(prefix); ok {
+		query = "SELECT word, COUNT(*) FROM words WHERE word >= ? AND word < ? GROUP BY word"
+		args = append(args, end)
+	}
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SQLiteTFIndex.Words cannot read the words starting with `%s`: %w", prefix, err)
+	}
+	defer rows.Close()
+	ret := map[string]uint{}
+	for rows.Next() {
+		word := ""
+		docFrequency := uint(0)
+		if err := rows.Scan(&word, &docFrequency); err != nil {
+			return nil, fmt.Errorf("SQLiteTFIndex.Words could not parse the rows into words: %w", err)
+		}
+		ret[word] = docFrequency
+	}
+	return ret, nil
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) DocFrequency(token string) (uint, error) {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return 0, err
+	}
+	docFrequency := uint(0)
+	if err := db.QueryRow("SELECT COUNT(*) FROM termFrequenciesIndex WHERE token = ?", token).Scan(&docFrequency); err != nil {
+		return 0, fmt.Errorf("SQLiteTFIndex.DocFrequency cannot count the documents containing `%s`: %w", token, err)
+	}
+	return docFrequency, nil
+}
+
+// Dictionary of the tokens of SQLiteTFIndex, every seek is a lookup of ix_token and every scan a range of it
+type sqliteDictionary struct {
+	db   *sql.DB
+	stmt *sql.Stmt
+}
+
+func (dictionary sqliteDictionary) seek(from string) (string, bool, error) {
+	token := ""
+	err := dictionary.stmt.QueryRow(from).Scan(&token)
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("sqliteDictionary.seek cannot seek the token following `%s`: %w", from, err)
+	}
+	return token, true, nil
+}
+
+func (dictionary sqliteDictionary) scan(prefix string, visit func(token string) bool) error {
+	query := "SELECT DISTINCT token FROM termFrequenciesIndex WHERE token >= ? ORDER BY token"
+	args := []any{prefix}
+	if end, ok := successor(prefix); ok {
+		query = "SELECT DISTINCT token FROM termFrequenciesIndex WHERE token >= ? AND token < ? ORDER BY token"
+		args = append(args, end)
+	}
+	rows, err := dictionary.db.Query(query, args...)
+	if err != nil {
+		return fmt.Errorf("sqliteDictionary.scan cannot read the tokens starting with `%s`: %w", prefix, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		token := ""
+		if err := rows.Scan(&token); err != nil {
+			return fmt.Errorf("sqliteDictionary.scan could not parse the rows into tokens: %w", err)
+		}
+		if !visit(token) {
+			break
+		}
+	}
+	return rows.Err()
+}
+
+// Prepares the dictionary of the tokens, to be closed once done
+func (sqliteTFIndex *SQLiteTFIndex) dictionary() (sqliteDictionary, error) {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return sqliteDictionary{}, err
+	}
+	stmt, err := db.Prepare("SELECT token FROM termFrequenciesIndex WHERE token >= ? ORDER BY token LIMIT 1")
+	if err != nil {
+		return sqliteDictionary{}, fmt.Errorf("SQLiteTFIndex.dictionary cannot prepare the seeks of the tokens: %w", err)
+	}
+	return sqliteDictionary{db: db, stmt: stmt}, nil
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) FuzzyTokens(prefix string, token string, maxDistance uint) (map[string]uint, error) {
+	dictionary, err := sqliteTFIndex.dictionary()
+	if err != nil {
+		return nil, err
+	}
+	defer dictionary.stmt.Close()
+	return fuzzyTokens(dictionary, prefix, token, maxDistance)
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) WildcardTokens(prefix string, pattern string, limit uint) ([]string, error) {
+	dictionary, err := sqliteTFIndex.dictionary()
+	if err != nil {
+		return nil, err
+	}
+	defer dictionary.stmt.Close()
+	return wildcardTokens(dictionary, prefix, pattern, limit)
+}
+
+// Resolves the fuzzy and the wildcard queries of the query, see resolveExpansions
+func (sqliteTFIndex *SQLiteTFIndex) resolveExpansions(searchQuery SearchQuery) (SearchQuery, error) {
+	dictionary, err := sqliteTFIndex.dictionary()
+	if err != nil {
+		return nil, err
+	}
+	defer dictionary.stmt.Close()
+	return resolveExpansions(dictionary, searchQuery)
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) Metadata(key string) (string, bool, error) {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return "", false, err
+	}
+	if ok, err := sqliteTFIndex.hasTable(db, "metadata"); err != nil || !ok {
+		return "", false, err
+	}
+	value := ""
+	err = db.QueryRow("SELECT value FROM metadata WHERE key = ?", key).Scan(&value)
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("SQLiteTFIndex.Metadata cannot read the metadata `%s`: %w", key, err)
+	}
+	return value, true, nil
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) SetMetadata(key string, value string) error {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec(`
+        INSERT INTO metadata (key, value) VALUES (?, ?)
+        ON CONFLICT(key) DO UPDATE SET value = excluded.value
+    `, key, value)
+	if err != nil {
+		return fmt.Errorf("SQLiteTFIndex.SetMetadata cannot write the metadata `%s`: %w", key, err)
+	}
+	return nil
+}
+
 func (sqliteTFIndex *SQLiteTFIndex) BulkUpdate(docTokens map[string][]string) error {
 	docTokensCh := make(chan DocTokens)
 	go func() {
 		for DocId, Tokens := range docTokens {
-			docTokensCh <- DocTokens{DocId, Tokens}
+			docTokensCh <- DocTokens{DocID: DocId, Tokens: Tokens, Length: uint(len(Tokens))}
 		}
 		close(docTokensCh)
 	}()
 	return sqliteTFIndex.BulkUpdateChan(docTokensCh)
 }
 
-func (sqliteTFIndex *SQLiteTFIndex) queryHelper(tokens []string, topN *uint) ([]QueryResult, error) {
-	if len(tokens) == 0 {
-		return nil, nil
+func (sqliteTFIndex *SQLiteTFIndex) AvgDocLength() (float64, error) {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return 0.0, err
+	}
+	avgDocLength := 0.0
+	err = db.QueryRow("SELECT value FROM metadata WHERE key = 'avgDocLength'").Scan(&avgDocLength)
+	if err == sql.ErrNoRows {
+		return 0.0, nil
 	}
-	args := []any{}
-	seenBefore := map[string]bool{}
-	for _, token := range tokens {
-		if _, ok := seenBefore[token]; ok {
-			continue
+	if err != nil {
+		return 0.0, fmt.Errorf("SQLiteTFIndex.AvgDocLength cannot read the average document length: %w", err)
+	}
+	return avgDocLength, nil
+}
+
+// Returns the SQL expression (along with its args) computing the score of a row of termFrequenciesIndex (aliased as t) joined with documents (aliased as d)
+func (sqliteTFIndex *SQLiteTFIndex) scoreExpr(scorer Scorer) (string, []any, error) {
+	switch scorer := scorer.(type) {
+	case TFIDFScorer:
+		return "t.frequency * t.inverseDocFrequency", nil, nil
+	case BM25Scorer:
+		avgDocLength, err := sqliteTFIndex.AvgDocLength()
+		if err != nil {
+			return "", nil, err
+		}
+		idf := "LN(1.0 + (t.totalDocuments - t.docFrequency + 0.5) / (t.docFrequency + 0.5))"
+		if avgDocLength <= 0.0 {
+			return idf + " * (t.frequency * (? + 1.0)) / (t.frequency + ?)", []any{scorer.K1, scorer.K1}, nil
 		}
-		args = append(args, token)
-		seenBefore[token] = true
+		return idf + " * (t.frequency * (? + 1.0)) / (t.frequency + ? * (1.0 - ? + ? * d.length / ?))", []any{scorer.K1, scorer.K1, scorer.B, scorer.B, avgDocLength}, nil
+	default:
+	}
+	return "", nil, fmt.Errorf("SQLiteTFIndex.scoreExpr: unsupported scorer `%s`", scorer.Name())
+}
+
+// Returns the SQL query (along with its args) selecting the filePaths and the scores of the documents matching any of
+// the tokens, including the documents whose score is zero (e.g. matching a token present in all the documents)
+func (sqliteTFIndex *SQLiteTFIndex) matchesQuery(boostedTokens []boostedToken, scorer Scorer) (string, []any, error) {
+	scoreExpr, args, err := sqliteTFIndex.scoreExpr(scorer)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, boosted := range boostedTokens {
+		args = append(args, boosted.token, boosted.boost)
 	}
 	query := `
         SELECT
-            filePath,
-            SUM(frequency * inverseDocFrequency) tfidf
-        FROM termFrequenciesIndex
-        WHERE token IN (?` + strings.Repeat(", ?", len(args)-1) + `)
+            t.filePath,
+            SUM((` + scoreExpr + `) * q.boost) score
+        FROM termFrequenciesIndex t
+        JOIN documents d
+            ON d.filePath = t.filePath
+        JOIN (SELECT column1 AS token, column2 AS boost FROM (VALUES (?, ?)` + strings.Repeat(", (?, ?)", len(boostedTokens)-1) + `)) q
+            ON q.token = t.token
         GROUP BY
-            filePath
+            t.filePath
+    `
+	return query, args, nil
+}
+
+// Returns the limit results following the first offset results, all of them when limit is nil
+func (sqliteTFIndex *SQLiteTFIndex) queryHelper(boostedTokens []boostedToken, offset uint, limit *uint, scorer Scorer) ([]QueryResult, error) {
+	if len(boostedTokens) == 0 {
+		return nil, nil
+	}
+	query, args, err := sqliteTFIndex.matchesQuery(boostedTokens, scorer)
+	if err != nil {
+		return nil, err
+	}
+	query += `
         ORDER BY
-            tfidf
-        DESC
+            score DESC,
+            t.filePath
     `
-	if topN != nil {
-		query += " LIMIT " + fmt.Sprintf("%d", *topN)
+	if limit != nil {
+		query += fmt.Sprintf(" LIMIT %d OFFSET %d", *limit, offset)
+	} else if offset > 0 {
+		query += fmt.Sprintf(" LIMIT -1 OFFSET %d", offset)
 	}
 	db, err := sqliteTFIndex.Connect()
 	if err != nil {
@@ -219,6 +836,7 @@ func (sqliteTFIndex *SQLiteTFIndex) queryHelper(tokens []string, topN *uint) ([]
 		argsAsJSON, _ := json.Marshal(args)
 		return nil, fmt.Errorf("SQLiteTFIndex.queryHelper cannot run the query `%s`, with args: %s: %w", query, argsAsJSON, err)
 	}
+	defer rows.Close()
 	ret := []QueryResult{}
 	for rows.Next() {
 		docId := ""
@@ -227,17 +845,137 @@ func (sqliteTFIndex *SQLiteTFIndex) queryHelper(tokens []string, topN *uint) ([]
 		if err != nil {
 			return nil, fmt.Errorf("SQLiteTFIndex.queryHelper could not parse the rows into QueryResult: %w", err)
 		}
-		if score > 0.0 {
-			ret = append(ret, QueryResult{DocID: docId, Score: score})
+		ret = append(ret, QueryResult{DocID: docId, Score: score})
+	}
+	return ret, nil
+}
+
+// Returns the number of the documents matching any of the tokens, counted apart from the page of the results
+func (sqliteTFIndex *SQLiteTFIndex) countHelper(boostedTokens []boostedToken, scorer Scorer) (uint, error) {
+	if len(boostedTokens) == 0 {
+		return 0, nil
+	}
+	query, args, err := sqliteTFIndex.matchesQuery(boostedTokens, scorer)
+	if err != nil {
+		return 0, err
+	}
+	query = "SELECT COUNT(*) FROM (" + query + ")"
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return 0, err
+	}
+	total := uint(0)
+	if err := db.QueryRow(query, args...).Scan(&total); err != nil {
+		argsAsJSON, _ := json.Marshal(args)
+		return 0, fmt.Errorf("SQLiteTFIndex.countHelper cannot run the query `%s`, with args: %s: %w", query, argsAsJSON, err)
+	}
+	return total, nil
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) corpusStats() (uint, float64, error) {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return 0, 0.0, err
+	}
+	totalDocuments := uint(0)
+	err = db.QueryRow("SELECT COUNT(*) FROM documents").Scan(&totalDocuments)
+	if err != nil {
+		return 0, 0.0, fmt.Errorf("SQLiteTFIndex.corpusStats cannot count the documents: %w", err)
+	}
+	avgDocLength, err := sqliteTFIndex.AvgDocLength()
+	if err != nil {
+		return 0, 0.0, err
+	}
+	return totalDocuments, avgDocLength, nil
+}
+
+func (sqliteTFIndex *SQLiteTFIndex) postings(token string, withPositions bool) (map[string]posting, error) {
+	db, err := sqliteTFIndex.Connect()
+	if err != nil {
+		return nil, err
+	}
+	query := `
+        SELECT
+            t.filePath,
+            t.frequency,
+            t.positions,
+            d.length
+        FROM termFrequenciesIndex t
+        JOIN documents d
+            ON d.filePath = t.filePath
+        WHERE t.token = ?
+    `
+	rows, err := db.Query(query, token)
+	if err != nil {
+		return nil, fmt.Errorf("SQLiteTFIndex.postings cannot run the query `%s`, with token: `%s`: %w", query, token, err)
+	}
+	defer rows.Close()
+	ret := map[string]posting{}
+	for rows.Next() {
+		docId := ""
+		positions := ""
+		p := posting{}
+		err := rows.Scan(&docId, &p.frequency, &positions, &p.docLength)
+		if err != nil {
+			return nil, fmt.Errorf("SQLiteTFIndex.postings could not parse the rows into posting: %w", err)
+		}
+		if withPositions {
+			err = json.Unmarshal([]byte(positions), &p.positions)
+			if err != nil {
+				return nil, fmt.Errorf("SQLiteTFIndex.postings could not parse the positions `%s`: %w", positions, err)
+			}
 		}
+		ret[docId] = p
 	}
 	return ret, nil
 }
 
-func (sqliteTFIndex *SQLiteTFIndex) Query(tokens []string) ([]QueryResult, error) {
-	return sqliteTFIndex.queryHelper(tokens, nil)
+// Plain bags of tokens are ranked by SQLite itself, all the other queries are evaluated over the postings of their tokens
+func (sqliteTFIndex *SQLiteTFIndex) Query(searchQuery SearchQuery, scorer Scorer) ([]QueryResult, error) {
+	searchQuery, err := sqliteTFIndex.resolveExpansions(searchQuery)
+	if err != nil {
+		return nil, err
+	}
+	if boostedTokens, ok := plainTokens(searchQuery); ok {
+		return sqliteTFIndex.queryHelper(boostedTokens, 0, nil, scorer)
+	}
+	return evaluate(sqliteTFIndex, searchQuery, scorer, false)
 }
 
-func (sqliteTFIndex *SQLiteTFIndex) QueryTopN(tokens []string, topN uint) ([]QueryResult, error) {
-	return sqliteTFIndex.queryHelper(tokens, &topN)
+// Explanations are computed over the postings of the tokens, the same way as SimpleTFINdex computes them
+func (sqliteTFIndex *SQLiteTFIndex) QueryTopN(searchQuery SearchQuery, topN uint, scorer Scorer, explain bool) ([]QueryResult, error) {
+	searchQuery, err := sqliteTFIndex.resolveExpansions(searchQuery)
+	if err != nil {
+		return nil, err
+	}
+	if boostedTokens, ok := plainTokens(searchQuery); ok && !explain {
+		return sqliteTFIndex.queryHelper(boostedTokens, 0, &topN, scorer)
+	}
+	results, err := evaluate(sqliteTFIndex, searchQuery, scorer, explain)
+	return results[:min(topN, uint(len(results)))], err
+}
+
+// Plain bags of tokens are paged with LIMIT/OFFSET and counted by a separate query, all the other queries are paged
+// after being evaluated
+func (sqliteTFIndex *SQLiteTFIndex) QueryPage(searchQuery SearchQuery, offset uint, limit uint, scorer Scorer, explain bool) (ResultPage, error) {
+	searchQuery, err := sqliteTFIndex.resolveExpansions(searchQuery)
+	if err != nil {
+		return ResultPage{}, err
+	}
+	if boostedTokens, ok := plainTokens(searchQuery); ok && !explain {
+		results, err := sqliteTFIndex.queryHelper(boostedTokens, offset, &limit, scorer)
+		if err != nil {
+			return ResultPage{}, err
+		}
+		total, err := sqliteTFIndex.countHelper(boostedTokens, scorer)
+		if err != nil {
+			return ResultPage{}, err
+		}
+		return ResultPage{Results: results, Total: total}, nil
+	}
+	results, err := evaluate(sqliteTFIndex, searchQuery, scorer, explain)
+	if err != nil {
+		return ResultPage{}, err
+	}
+	return pageOf(results, offset, limit), nil
 }
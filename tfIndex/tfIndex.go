@@ -3,19 +3,110 @@ package tfIndex
 type QueryResult struct {
 	DocID string
 	Score float64
+	// Contributions of the tokens of the query towards the score, only set when explaining the results
+	Explanation []TokenExplanation
+}
+
+// Page of the ranked results of a query
+type ResultPage struct {
+	Results []QueryResult
+	// Number of the results of the query over all the pages
+	Total uint
+}
+
+// Returns the page of the limit results following the first offset results
+func pageOf(results []QueryResult, offset uint, limit uint) ResultPage {
+	total := uint(len(results))
+	start := min(offset, total)
+	end := start + min(limit, total-start)
+	return ResultPage{Results: results[start:end], Total: total}
+}
+
+// Metadata of the file a document is built from, used for detecting the changes in the file
+type DocMeta struct {
+	Size int64 `json:"size"`
+	// Modification time of the file in unix nanoseconds
+	ModTime int64  `json:"modTime"`
+	Hash    string `json:"hash"`
+	// Title of the document, empty when it has none
+	Title string `json:"title,omitempty"`
+	// ISO 639-1 code of the language of the document, empty when it is not known
+	Language string `json:"language,omitempty"`
 }
 
 type DocTokens struct {
 	DocID  string
 	Tokens []string
+	// Positions[i] is the position of Tokens[i] in the document, when nil the index of the token is used as its position
+	Positions []uint
+	// Number of the words of the document, i.e. the length of the document ranked by BM25, which leaves out the
+	// expansions of the words and the tokens of the other fields than the body
+	Length uint
+	// Distinct words of the document as written, only normalized, which complete the partially typed words of the
+	// queries. They are stored once per document apart from the tokens, without positions
+	Words []string
+	Meta  DocMeta
+}
+
+// Returns the positions of the tokens in the document
+func (docTokens DocTokens) TokenPositions() []uint {
+	if docTokens.Positions != nil {
+		return docTokens.Positions
+	}
+	positions := make([]uint, len(docTokens.Tokens))
+	for i := range docTokens.Tokens {
+		positions[i] = uint(i)
+	}
+	return positions
 }
 
 type TFIndex interface {
-	Update(docId string, tokens []string) error
+	// Indexes the document with the given tokens, replacing the document if it is already indexed. The length is the
+	// number of the words of the document, see DocTokens.Length
+	Update(docId string, tokens []string, length uint) error
+	// Indexes the documents with the given tokens, the lengths of the documents are the numbers of their tokens
 	BulkUpdate(docTokens map[string][]string) error
 	BulkUpdateChan(docTokensCH <-chan DocTokens) error
-	Query(tokens []string) ([]QueryResult, error)
-	QueryTopN(tokens []string, topN uint) ([]QueryResult, error)
+	// Removes the document from the index, deleting a document which is not indexed is a no-op
+	Delete(docId string) error
+	BulkDelete(docIds []string) error
+	// Returns the metadata of all the indexed documents keyed by docId
+	Documents() (map[string]DocMeta, error)
+	// Returns the metadata of the indexed document, ok is false when the document is not indexed
+	Document(docId string) (meta DocMeta, ok bool, err error)
+	// Checks if the index has neither documents nor tokens, the indexes of the legacy formats have tokens without
+	// documents
+	IsEmpty() (bool, error)
+	// Removes all the documents along with their tokens, keeping the metadata of the index
+	Clear() error
+	// Replaces the metadata of the indexed documents keyed by docId, leaving their tokens untouched. The documents
+	// which are not indexed are skipped
+	UpdateMetas(metas map[string]DocMeta) error
+	// Returns the document frequencies of the indexed tokens starting with the prefix, keyed by token
+	Vocabulary(prefix string) (map[string]uint, error)
+	// Returns the numbers of the documents containing the words starting with the prefix keyed by word, see
+	// DocTokens.Words
+	Words(prefix string) (map[string]uint, error)
+	// Returns the number of the documents containing the token, 0 when it is not indexed
+	DocFrequency(token string) (uint, error)
+	// Returns the indexed tokens made of the prefix followed by a suffix within maxDistance edits of the token, keyed by
+	// token with their edit distances
+	FuzzyTokens(prefix string, token string, maxDistance uint) (map[string]uint, error)
+	// Returns the indexed tokens made of the prefix followed by a suffix matching the pattern of `*` and `?` wildcards,
+	// failing with TooManyExpansionsError when more than limit tokens match
+	WildcardTokens(prefix string, pattern string, limit uint) ([]string, error)
+	Query(searchQuery SearchQuery, scorer Scorer) ([]QueryResult, error)
+	// Returns the topN results of the query, along with the explanations of their scores when explain is set
+	QueryTopN(searchQuery SearchQuery, topN uint, scorer Scorer, explain bool) ([]QueryResult, error)
+	// Returns the limit results of the query following the first offset results along with the total number of the
+	// results, the explanations of their scores are included when explain is set. SQLiteTFIndex only pages the plain
+	// bags of tokens (see plainTokens) in SQL when explain is not set, the other queries are evaluated over all their
+	// matching documents before the page is sliced
+	QueryPage(searchQuery SearchQuery, offset uint, limit uint, scorer Scorer, explain bool) (ResultPage, error)
+	// Returns the value stored in the index under the key, ok is false when the key is not set
+	Metadata(key string) (value string, ok bool, err error)
+	// Stores the value in the index under the key, replacing its previous value
+	SetMetadata(key string, value string) error
 }
 
 func TermFrequency(tokens []string) map[string]uint {
@@ -25,3 +116,11 @@ func TermFrequency(tokens []string) map[string]uint {
 	}
 	return ret
 }
+
+func TermPositions(tokens []string, positions []uint) map[string][]uint {
+	ret := map[string][]uint{}
+	for i, token := range tokens {
+		ret[token] = append(ret[token], positions[i])
+	}
+	return ret
+}
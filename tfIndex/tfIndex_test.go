@@ -0,0 +1,82 @@
+package tfIndex
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+type pageCase struct {
+	offset uint
+	limit  uint
+	// docIds of the results of the page
+	docIds []string
+}
+
+// Results paged by the page cases, ranked by their docIds
+var pagedResults = []QueryResult{{DocID: "r0"}, {DocID: "r1"}, {DocID: "r2"}, {DocID: "r3"}, {DocID: "r4"}}
+
+var pageCases = []pageCase{
+	{0, 2, []string{"r0", "r1"}},
+	{2, 2, []string{"r2", "r3"}},
+	{4, 2, []string{"r4"}},
+	{5, 2, []string{}},
+	{9, 2, []string{}},
+	{0, 0, []string{}},
+	{0, 10, []string{"r0", "r1", "r2", "r3", "r4"}},
+	{3, ^uint(0), []string{"r3", "r4"}},
+}
+
+// Returns the docIds of the results
+func resultDocIds(results []QueryResult) []string {
+	ret := []string{}
+	for _, result := range results {
+		ret = append(ret, result.DocID)
+	}
+	return ret
+}
+
+func TestPageOf(t *testing.T) {
+	for _, c := range pageCases {
+		page := pageOf(pagedResults, c.offset, c.limit)
+		if got := resultDocIds(page.Results); !slices.Equal(got, c.docIds) || page.Total != uint(len(pagedResults)) {
+			t.Errorf("pageOf(%d, %d) = %v of %d, want %v of %d", c.offset, c.limit, got, page.Total, c.docIds, len(pagedResults))
+		}
+	}
+}
+
+// Queries paged by TestQueryPage, the plain bags of tokens are paged in SQL by SQLiteTFIndex unless explained
+var pagedQueries = []SearchQuery{
+	BooleanQuery{Optional: terms("raft", "pool", "timeout", "size")},
+	BooleanQuery{Optional: terms("raft", "pool"), Excluded: terms("size")},
+	BooleanQuery{Required: terms("timeout"), Optional: terms("pool")},
+	phrase("connection", "pool"),
+	BooleanQuery{Optional: terms("missing")},
+}
+
+// Checks that every page of the queries holds the results of Query following the offset, along with their total
+// number, whether the scores are explained or not
+func TestQueryPage(t *testing.T) {
+	for name, index := range testIndexes(t) {
+		for _, query := range pagedQueries {
+			results, err := index.Query(query, TFIDFScorer{})
+			if err != nil {
+				t.Fatalf("%s: Query(%v) failed: %s", name, query, err)
+			}
+			for _, explain := range []bool{false, true} {
+				for offset := uint(0); offset <= uint(len(results))+1; offset++ {
+					call := fmt.Sprintf("%s: QueryPage(%v, %d, 2, explain=%t)", name, query, offset, explain)
+					page, err := index.QueryPage(query, offset, 2, TFIDFScorer{}, explain)
+					if err != nil {
+						t.Errorf("%s failed: %s", call, err)
+						continue
+					}
+					want := resultDocIds(pageOf(results, offset, 2).Results)
+					if got := resultDocIds(page.Results); !slices.Equal(got, want) || page.Total != uint(len(results)) {
+						t.Errorf("%s = %v of %d, want %v of %d", call, got, page.Total, want, len(results))
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package tfIndex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Wildcards of the patterns of WildcardQuery, `*` matches any runes and `?` a single rune
+const (
+	AnyRunes = '*'
+	AnyRune  = '?'
+)
+
+// Pattern matching the indexed tokens made of Prefix (e.g. the field of the tokens) followed by a suffix matching
+// Pattern, see WildcardTokens. The query fails with TooManyExpansionsError when the pattern matches more than
+// MaxExpansions tokens. Boost multiplies the scores of the tokens, an unset (zero) Boost is treated as 1. The tokens
+// with a `:` after the prefix, i.e. the tokens of the other fields, never match
+type WildcardQuery struct {
+	Prefix        string
+	Pattern       string
+	MaxExpansions uint
+	Boost         float64
+}
+
+// Term query of the pattern, the wildcard queries are resolved into term queries before being evaluated, see
+// resolveExpansions
+func (wildcardQuery WildcardQuery) exact() TermQuery {
+	return TermQuery{Token: wildcardQuery.Prefix + wildcardQuery.Pattern, Boost: wildcardQuery.Boost}
+}
+
+func (wildcardQuery WildcardQuery) collect(collector *queryCollector, positive bool) {
+	wildcardQuery.exact().collect(collector, positive)
+}
+
+func (wildcardQuery WildcardQuery) match(postingsByToken map[string]map[string]posting) (docSet, docSet) {
+	return wildcardQuery.exact().match(postingsByToken)
+}
+
+// Error of a pattern matching more tokens than the limit of its expansions
+type TooManyExpansionsError struct {
+	Pattern string
+	Limit   uint
+}
+
+func (err *TooManyExpansionsError) Error() string {
+	return fmt.Sprintf("tfIndex: `%s` matches more than %d tokens, please make the pattern more specific", err.Pattern, err.Limit)
+}
+
+// Checks if the text matches the pattern, see WildcardQuery
+func wildcardMatch(pattern []rune, text []rune) bool {
+	// on a mismatch the last `*` seen is retried matching one more rune of the text
+	p, t, star, starText := 0, 0, -1, 0
+	for t < len(text) {
+		switch {
+		case p < len(pattern) && (pattern[p] == AnyRune || pattern[p] == text[t]):
+			p, t = p+1, t+1
+		case p < len(pattern) && pattern[p] == AnyRunes:
+			star, starText = p, t
+			p++
+		case star >= 0:
+			starText++
+			p, t = star+1, starText
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == AnyRunes {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// Returns the indexed tokens made of the prefix followed by a suffix matching the pattern in their byte order. Only
+// the range of the tokens starting with the literal prefix of the pattern (conf of conf*g) is scanned, the patterns
+// starting with a wildcard scan all the tokens. Fails with TooManyExpansionsError when more than limit tokens match
+func wildcardTokens(dictionary termDictionary, prefix string, pattern string, limit uint) ([]string, error) {
+	literal, _, _ := strings.Cut(pattern, string(AnyRunes))
+	literal, _, _ = strings.Cut(literal, string(AnyRune))
+	compiled := []rune(pattern)
+	ret := []string{}
+	var tooMany error
+	err := dictionary.scan(prefix+literal, func(token string) bool {
+		suffix := token[len(prefix):]
+		if strings.Contains(suffix, ":") || !wildcardMatch(compiled, []rune(suffix)) {
+			return true
+		}
+		if uint(len(ret)) == limit {
+			tooMany = &TooManyExpansionsError{Pattern: prefix + pattern, Limit: limit}
+			return false
+		}
+		ret = append(ret, token)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tooMany != nil {
+		return nil, tooMany
+	}
+	return ret, nil
+}
@@ -0,0 +1,64 @@
+package tfIndex
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+type wildcardCase struct {
+	prefix  string
+	pattern string
+	limit   uint
+	// Matching tokens in their byte order, nil when more than limit tokens match
+	tokens []string
+}
+
+var wildcardCases = []wildcardCase{
+	{"", "r*", 10, []string{"raft", "reached"}},
+	{"", "*e*", 10, []string{"connection", "election", "leader", "reached", "size", "timeout"}},
+	{"", "*t*", 10, []string{"connection", "election", "raft", "timeout"}},
+	{"", "?aft", 10, []string{"raft"}},
+	{"", "po?l", 10, []string{"pool"}},
+	{"", "*o?", 10, []string{"connection", "election", "pool"}},
+	{"", "raft", 10, []string{"raft"}},
+	{"", "r?", 10, []string{}},
+	{"title:", "r*", 10, []string{"title:raft"}},
+	{"title:", "*", 10, []string{"title:raft"}},
+	{"path:", "*", 10, []string{}},
+	{"", "*", 8, []string{"connection", "election", "leader", "pool", "raft", "reached", "size", "timeout"}},
+	{"", "*e*", 6, []string{"connection", "election", "leader", "reached", "size", "timeout"}},
+	{"", "*e*", 5, nil},
+	{"", "*", 0, nil},
+}
+
+func TestWildcardTokens(t *testing.T) {
+	for name, index := range testIndexes(t) {
+		for _, c := range wildcardCases {
+			tokens, err := index.WildcardTokens(c.prefix, c.pattern, c.limit)
+			var tooMany *TooManyExpansionsError
+			switch {
+			case c.tokens == nil && !errors.As(err, &tooMany):
+				t.Errorf("%s: WildcardTokens(%q, %q, %d) = %v, %v, want a TooManyExpansionsError", name, c.prefix, c.pattern, c.limit, tokens, err)
+			case c.tokens == nil && (tooMany.Pattern != c.prefix+c.pattern || tooMany.Limit != c.limit):
+				t.Errorf("%s: WildcardTokens(%q, %q, %d) failed with %s", name, c.prefix, c.pattern, c.limit, err)
+			case c.tokens != nil && err != nil:
+				t.Errorf("%s: WildcardTokens(%q, %q, %d) failed: %s", name, c.prefix, c.pattern, c.limit, err)
+			case c.tokens != nil && !slices.Equal(tokens, c.tokens):
+				t.Errorf("%s: WildcardTokens(%q, %q, %d) = %v, want %v", name, c.prefix, c.pattern, c.limit, tokens, c.tokens)
+			}
+		}
+	}
+}
+
+var wildcardMatches = []matchCase{
+	{WildcardQuery{Pattern: "tim*", MaxExpansions: 10}, []string{"a", "c"}},
+	{WildcardQuery{Pattern: "?ize", MaxExpansions: 10}, []string{"c"}},
+	{WildcardQuery{Pattern: "x*", MaxExpansions: 10}, []string{}},
+	{WildcardQuery{Prefix: "title:", Pattern: "*", MaxExpansions: 10}, []string{"d"}},
+	{BooleanQuery{Required: []SearchQuery{WildcardQuery{Pattern: "r*", MaxExpansions: 10}}, Excluded: terms("leader")}, []string{"c"}},
+}
+
+func TestWildcardQuery(t *testing.T) {
+	testMatches(t, wildcardMatches)
+}
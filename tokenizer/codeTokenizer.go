@@ -0,0 +1,143 @@
+package tokenizer
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// CodeTokenizer for parsing the identifiers of source code from string:
+//   - letters, digits, combining marks and connector punctuation (`_`) form identifiers (parseHTTPRequest, foo_bar)
+//   - periods between identifiers are part of them (fmt.Println, 3.14)
+//   - the runs of CJK characters are split into overlapping bigrams, as by UnicodeTokenizer
+//   - whitespaces, the rest of the punctuations and the symbols are skipped
+//
+// See SplitIdentifier for the sub-words of the identifiers
+type CodeTokenizer struct {
+	content string
+	// Number of bytes consumed from the start of the content
+	offset int
+	// Byte offset of the next bigram of the current run of CJK characters, -1 outside the runs
+	nextBigram int
+}
+
+// Construct CodeTokenizer from a string
+func CodeTokenizerFromString(content string) *CodeTokenizer {
+	return &CodeTokenizer{content: content, nextBigram: -1}
+}
+
+// Returns the rune at the byte offset along with its size, utf8.RuneError with size 0 at the end of the content
+func (codeTokenizer *CodeTokenizer) runeAt(offset int) (rune, int) {
+	if offset >= len(codeTokenizer.content) {
+		return utf8.RuneError, 0
+	}
+	return utf8.DecodeRuneInString(codeTokenizer.content[offset:])
+}
+
+// Skips the runes which cannot start an identifier
+func (codeTokenizer *CodeTokenizer) skipSeparators() {
+	for {
+		r, size := codeTokenizer.runeAt(codeTokenizer.offset)
+		if size == 0 || startsWord(r) {
+			return
+		}
+		codeTokenizer.offset += size
+	}
+}
+
+// Returns the byte offset following the last returned token
+func (codeTokenizer *CodeTokenizer) Offset() int {
+	return codeTokenizer.offset
+}
+
+// Checks if the codeTokenizer still contain tokens
+func (codeTokenizer *CodeTokenizer) Contains() bool {
+	if codeTokenizer.nextBigram >= 0 {
+		return true
+	}
+	codeTokenizer.skipSeparators()
+	return codeTokenizer.offset < len(codeTokenizer.content)
+}
+
+// Returns next identifier from the codeTokenizer, also moves the codeTokenizer to next identifiers position. Returns an
+// empty string when there are no more identifiers
+func (codeTokenizer *CodeTokenizer) NextToken() string {
+	start := codeTokenizer.nextBigram
+	if start < 0 {
+		codeTokenizer.skipSeparators()
+		start = codeTokenizer.offset
+	}
+	first, size := codeTokenizer.runeAt(start)
+	if size == 0 {
+		return ""
+	}
+	end := start + size
+	if IsCJK(first) {
+		end, codeTokenizer.nextBigram = cjkToken(codeTokenizer.content, start)
+	} else {
+		for {
+			r, size := codeTokenizer.runeAt(end)
+			if size == 0 || IsCJK(r) {
+				break
+			}
+			if isWordRune(r) {
+				end += size
+				continue
+			}
+			next, nextSize := codeTokenizer.runeAt(end + size)
+			if r != '.' || nextSize == 0 || IsCJK(next) || !startsWord(next) {
+				break
+			}
+			end += size + nextSize
+		}
+	}
+	codeTokenizer.offset = end
+	return codeTokenizer.content[start:end]
+}
+
+func (codeTokenizer *CodeTokenizer) Tokens() []string {
+	ret := []string{}
+	for codeTokenizer.Contains() {
+		ret = append(ret, codeTokenizer.NextToken())
+	}
+	return ret
+}
+
+// Checks if the identifier has a sub-word starting at the index of its runes: at the uppercase letters following the
+// lowercase letters or the digits (parse|Request, sha256|Sum) and at the last uppercase letter of a run of them followed
+// by a lowercase letter (HTTP|Request). The digits are kept attached to the letters around them (utf8, base64, v2beta)
+func startsSubWord(runes []rune, i int) bool {
+	if i == 0 || !unicode.IsUpper(runes[i]) {
+		return false
+	}
+	previous := runes[i-1]
+	if unicode.IsLower(previous) || unicode.IsNumber(previous) {
+		return true
+	}
+	return unicode.IsUpper(previous) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+}
+
+// Returns the sub-words of the identifier split at the camelCase boundaries and at the runes other than the letters and
+// the digits (`_`, `.`), e.g. parse, HTTP and Request for parseHTTPRequest. Returns nothing when the identifier is a
+// single word
+func SplitIdentifier(identifier string) []string {
+	var ret []string
+	runes := []rune(identifier)
+	start := -1
+	for i, r := range runes {
+		isPart := unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r)
+		if start >= 0 && (!isPart || startsSubWord(runes, i)) {
+			ret = append(ret, string(runes[start:i]))
+			start = -1
+		}
+		if isPart && start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		ret = append(ret, string(runes[start:]))
+	}
+	if len(ret) == 1 && ret[0] == identifier {
+		return nil
+	}
+	return ret
+}
@@ -5,24 +5,33 @@ import "unicode"
 // SimpleTokenizer for parsing tokens from string
 type SimpleTokenizer struct {
 	content string
+	// Number of bytes consumed from the start of the content
+	offset int
 }
 
 // Construct SimpleTokenizer from a string
 func SimpleTokenizerFromString(content string) *SimpleTokenizer {
-	return &SimpleTokenizer{content}
+	return &SimpleTokenizer{content: content}
 }
 
 // Trim whitespaces from left
 func (simpleTokenizer *SimpleTokenizer) TrimLeft() {
 	for len(simpleTokenizer.content) > 0 && unicode.IsSpace(rune(simpleTokenizer.content[0])) {
 		simpleTokenizer.content = simpleTokenizer.content[1:]
+		simpleTokenizer.offset++
 	}
 }
 
+// Returns the byte offset following the last returned token
+func (simpleTokenizer *SimpleTokenizer) Offset() int {
+	return simpleTokenizer.offset
+}
+
 // Chop n bytes from left
 func (simpleTokenizer *SimpleTokenizer) ChopLeft(n int) string {
 	token := simpleTokenizer.content[:n]
 	simpleTokenizer.content = simpleTokenizer.content[n:]
+	simpleTokenizer.offset += n
 	return token
 }
 
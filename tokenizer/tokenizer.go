@@ -7,4 +7,6 @@ type Tokenizer interface {
 	NextToken() string
 	// Returns a slice of tokens from the tokenizer
 	Tokens() []string
+	// Returns the byte offset following the last returned token, i.e. the token spans [Offset() - len(token), Offset())
+	Offset() int
 }
@@ -0,0 +1,157 @@
+package tokenizer
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// UnicodeTokenizer for parsing words from string, splitting at the Unicode word boundaries in the spirit of UAX #29:
+//   - letters, digits, combining marks and connector punctuation (`_`) form words
+//   - apostrophes and periods between letters (don't, e.g) and periods, commas and semicolons between digits
+//     (3.14, 1,000) are part of the words
+//   - hyphenated words are split into their parts (state-of-the-art gives state, of, the, art)
+//   - the runs of CJK characters are split into overlapping bigrams, see cjkToken
+//   - whitespaces, the rest of the punctuations and the symbols are skipped
+type UnicodeTokenizer struct {
+	content string
+	// Number of bytes consumed from the start of the content
+	offset int
+	// Byte offset of the next bigram of the current run of CJK characters, -1 outside the runs
+	nextBigram int
+}
+
+// Construct UnicodeTokenizer from a string
+func UnicodeTokenizerFromString(content string) *UnicodeTokenizer {
+	return &UnicodeTokenizer{content: content, nextBigram: -1}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r) || unicode.Is(unicode.Pc, r)
+}
+
+// Checks if a word can start with the rune, the combining marks only extend the preceding rune
+func startsWord(r rune) bool {
+	return isWordRune(r) && !unicode.IsMark(r)
+}
+
+// Checks if the rune is a Chinese, Japanese or Korean character, the texts in these languages have no spaces between
+// their words
+func IsCJK(r rune) bool {
+	// the prolonged sound mark (ー) is shared by hiragana and katakana
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) || r == 'ー'
+}
+
+// Returns the end of the token of the run of CJK characters of the content starting at the byte offset, along with the
+// start of the next token of the run, -1 when the run ends with the token. The runs are split into overlapping bigrams
+// (日本語 gives 日本 and 本語), so the words of the texts can be searched without knowing their boundaries, the
+// single characters are tokens of their own
+func cjkToken(content string, start int) (end int, next int) {
+	_, size := utf8.DecodeRuneInString(content[start:])
+	end = start + size
+	if end >= len(content) {
+		return end, -1
+	}
+	second, secondSize := utf8.DecodeRuneInString(content[end:])
+	if !IsCJK(second) {
+		return end, -1
+	}
+	next, end = end, end+secondSize
+	if third, _ := utf8.DecodeRuneInString(content[end:]); end >= len(content) || !IsCJK(third) {
+		next = -1
+	}
+	return end, next
+}
+
+// Checks if the rune joins the letters on its both sides into a single word
+func isMidLetter(r rune) bool {
+	return r == '\'' || r == '’' || r == '.' || r == '·'
+}
+
+// Checks if the rune joins the digits on its both sides into a single number
+func isMidNumber(r rune) bool {
+	return r == '.' || r == ',' || r == ';' || r == '\'' || r == '’'
+}
+
+// Returns the rune at the byte offset along with its size, utf8.RuneError with size 0 at the end of the content
+func (unicodeTokenizer *UnicodeTokenizer) runeAt(offset int) (rune, int) {
+	if offset >= len(unicodeTokenizer.content) {
+		return utf8.RuneError, 0
+	}
+	return utf8.DecodeRuneInString(unicodeTokenizer.content[offset:])
+}
+
+// Skips the runes which cannot start a word
+func (unicodeTokenizer *UnicodeTokenizer) skipSeparators() {
+	for {
+		r, size := unicodeTokenizer.runeAt(unicodeTokenizer.offset)
+		if size == 0 || startsWord(r) {
+			return
+		}
+		unicodeTokenizer.offset += size
+	}
+}
+
+// Returns the byte offset following the last returned token
+func (unicodeTokenizer *UnicodeTokenizer) Offset() int {
+	return unicodeTokenizer.offset
+}
+
+// Checks if the unicodeTokenizer still contain tokens
+func (unicodeTokenizer *UnicodeTokenizer) Contains() bool {
+	if unicodeTokenizer.nextBigram >= 0 {
+		return true
+	}
+	unicodeTokenizer.skipSeparators()
+	return unicodeTokenizer.offset < len(unicodeTokenizer.content)
+}
+
+// Returns next word from the unicodeTokenizer, also moves the unicodeTokenizer to next words position. Returns an
+// empty string when there are no more words
+func (unicodeTokenizer *UnicodeTokenizer) NextToken() string {
+	start := unicodeTokenizer.nextBigram
+	if start < 0 {
+		unicodeTokenizer.skipSeparators()
+		start = unicodeTokenizer.offset
+	}
+	first, size := unicodeTokenizer.runeAt(start)
+	if size == 0 {
+		return ""
+	}
+	end := start + size
+	if IsCJK(first) {
+		end, unicodeTokenizer.nextBigram = cjkToken(unicodeTokenizer.content, start)
+	} else {
+		previous := first
+		for {
+			r, size := unicodeTokenizer.runeAt(end)
+			if size == 0 || IsCJK(r) {
+				break
+			}
+			if isWordRune(r) {
+				if !unicode.IsMark(r) {
+					previous = r
+				}
+				end += size
+				continue
+			}
+			next, nextSize := unicodeTokenizer.runeAt(end + size)
+			joinsLetters := isMidLetter(r) && unicode.IsLetter(previous) && unicode.IsLetter(next)
+			joinsNumbers := isMidNumber(r) && unicode.IsNumber(previous) && unicode.IsNumber(next)
+			if nextSize == 0 || IsCJK(next) || !(joinsLetters || joinsNumbers) {
+				break
+			}
+			previous = next
+			end += size + nextSize
+		}
+	}
+	unicodeTokenizer.offset = end
+	return unicodeTokenizer.content[start:end]
+}
+
+func (unicodeTokenizer *UnicodeTokenizer) Tokens() []string {
+	ret := []string{}
+	for unicodeTokenizer.Contains() {
+		ret = append(ret, unicodeTokenizer.NextToken())
+	}
+	return ret
+}
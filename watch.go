@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"gosen/slog"
+	"gosen/tfIndex"
+	"gosen/watcher"
+	"os"
+)
+
+// Applies the changes of the files at the paths to the index. The files are read and tokenized without holding the
+// index lock, so that the index keeps on answering the queries until the changes are ready to be applied
+func syncPaths(index tfIndex.TFIndex, paths []string) error {
+	indexLock.RLock()
+	indexedDocs, err := index.Documents()
+	indexLock.RUnlock()
+	if err != nil {
+		return err
+	}
+	changes, err := detectChanges(paths, indexedDocs)
+	if err != nil {
+		return err
+	}
+	if changes.isEmpty() {
+		return nil
+	}
+	logChanges(changes)
+	var docTokens []tfIndex.DocTokens
+	for docToken := range changedDocTokens(changes) {
+		docTokens = append(docTokens, docToken)
+	}
+	docTokensCH := make(chan tfIndex.DocTokens, len(docTokens))
+	for _, docToken := range docTokens {
+		docTokensCH <- docToken
+	}
+	close(docTokensCH)
+	indexLock.Lock()
+	defer indexLock.Unlock()
+	if err := index.BulkDelete(changes.deleted); err != nil {
+		return err
+	}
+	if err := index.UpdateMetas(changes.touched); err != nil {
+		return err
+	}
+	if err := index.BulkUpdateChan(docTokensCH); err != nil {
+		return err
+	}
+	return saveIndex(index)
+}
+
+// Brings the index up to date with the directory and starts watching it for changes
+func watchDirectory(index tfIndex.TFIndex, dirPath string) (*watcher.Watcher, error) {
+	w, err := watcher.Watch(dirPath, debounce)
+	if err != nil {
+		return nil, err
+	}
+	slog.Infof("Watching directory `%s` for changes...", dirPath)
+	if err := syncPaths(index, []string{dirPath}); err != nil {
+		w.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+func applyWatchedChanges(index tfIndex.TFIndex, w *watcher.Watcher) {
+	for paths := range w.Changes {
+		if err := syncPaths(index, paths); err != nil {
+			slog.Errorf("applyWatchedChanges: error occurred while applying the changes of %d paths: %s", len(paths), err)
+		}
+	}
+}
+
+func watch(program string) {
+	watchFlagSet.Parse(os.Args)
+	if dirPath == "" {
+		fmt.Println("Did not provide the directory to watch!")
+		usage(program)
+	}
+	index := mkIndex(program, watchSubCommand)
+	switch index.(type) {
+	case *tfIndex.SQLiteTFIndex:
+		defer index.(*tfIndex.SQLiteTFIndex).Close()
+	default:
+	}
+	w, err := watchDirectory(index, dirPath)
+	if err != nil {
+		slog.Fatal(err)
+	}
+	defer w.Close()
+	applyWatchedChanges(index, w)
+}
@@ -0,0 +1,116 @@
+package watcher
+
+import (
+	"errors"
+	"fmt"
+	"gosen/slog"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+const watchMask uint32 = syscall.IN_CREATE |
+	syscall.IN_MODIFY |
+	syscall.IN_CLOSE_WRITE |
+	syscall.IN_DELETE |
+	syscall.IN_MOVED_FROM |
+	syscall.IN_MOVED_TO |
+	syscall.IN_DELETE_SELF |
+	syscall.IN_MOVE_SELF
+
+type inotifySource struct {
+	fd      int
+	file    *os.File
+	root    string
+	watches map[int32]string
+}
+
+func newEventSource(dirPath string, events chan<- string) (eventSource, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return nil, fmt.Errorf("newEventSource: cannot initialize inotify: %w", err)
+	}
+	// The file is non blocking, hence reads go through the runtime poller and are interrupted on close
+	source := &inotifySource{fd: fd, file: os.NewFile(uintptr(fd), "inotify"), root: dirPath, watches: map[int32]string{}}
+	if err := source.addRecursive(dirPath); err != nil {
+		source.close()
+		return nil, err
+	}
+	go source.readEvents(events)
+	return source, nil
+}
+
+func (source *inotifySource) addRecursive(dirPath string) error {
+	return filepath.WalkDir(dirPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// The directory might have been removed in the meanwhile
+			if path != dirPath && errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		wd, err := syscall.InotifyAddWatch(source.fd, path, watchMask)
+		if err != nil {
+			return fmt.Errorf("inotifySource.addRecursive: cannot watch the directory `%s`: %w", path, err)
+		}
+		source.watches[int32(wd)] = path
+		return nil
+	})
+}
+
+func (source *inotifySource) readEvents(events chan<- string) {
+	defer close(events)
+	buf := make([]byte, (syscall.SizeofInotifyEvent+syscall.NAME_MAX+1)*64)
+	for {
+		n, err := source.file.Read(buf)
+		if err != nil {
+			if !errors.Is(err, os.ErrClosed) {
+				slog.Errorf("inotifySource.readEvents: failed reading the events: %s", err)
+			}
+			return
+		}
+		for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+			event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameStart := offset + syscall.SizeofInotifyEvent
+			name := strings.TrimRight(string(buf[nameStart:nameStart+int(event.Len)]), "\x00")
+			offset = nameStart + int(event.Len)
+			source.handleEvent(event.Wd, event.Mask, name, events)
+		}
+	}
+}
+
+func (source *inotifySource) handleEvent(wd int32, mask uint32, name string, events chan<- string) {
+	if mask&syscall.IN_Q_OVERFLOW != 0 {
+		slog.Errorf("inotifySource: event queue overflowed, rescanning `%s`", source.root)
+		events <- source.root
+		return
+	}
+	dirPath, ok := source.watches[wd]
+	if !ok {
+		return
+	}
+	if mask&syscall.IN_IGNORED != 0 {
+		delete(source.watches, wd)
+		return
+	}
+	path := dirPath
+	if name != "" {
+		path = filepath.Join(dirPath, name)
+	}
+	if mask&syscall.IN_ISDIR != 0 && mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 {
+		if err := source.addRecursive(path); err != nil {
+			slog.Errorf("inotifySource: %s", err)
+		}
+	}
+	events <- path
+}
+
+func (source *inotifySource) close() error {
+	return source.file.Close()
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+
+package watcher
+
+import (
+	"errors"
+)
+
+func newEventSource(dirPath string, events chan<- string) (eventSource, error) {
+	return nil, errors.New("newEventSource: watching directories is only supported on linux")
+}
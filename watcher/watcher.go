@@ -0,0 +1,84 @@
+package watcher
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const eventsBufferSize uint = 1024
+
+// Pending changes are flushed after at most maxWaitFactor * debounce, even when the events keep on arriving
+const maxWaitFactor = 10
+
+// Source of raw change events, implemented per platform
+type eventSource interface {
+	close() error
+}
+
+// Watcher watches a directory tree recursively and reports the changed paths in debounced batches
+type Watcher struct {
+	// Batches of paths which have been created, modified or deleted. A path might refer to a directory, in which case
+	// anything under it might have changed
+	Changes <-chan []string
+	source  eventSource
+}
+
+// Starts watching the directory tree. Bursts of events are coalesced into a single batch, which is emitted once no
+// event has arrived for the debounce duration
+func Watch(dirPath string, debounce time.Duration) (*Watcher, error) {
+	absDirPath, err := filepath.Abs(dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("Watch: cannot resolve the absolute path of `%s`: %w", dirPath, err)
+	}
+	events := make(chan string, eventsBufferSize)
+	source, err := newEventSource(absDirPath, events)
+	if err != nil {
+		return nil, fmt.Errorf("Watch: cannot watch the directory `%s`: %w", dirPath, err)
+	}
+	changes := make(chan []string)
+	go debounceEvents(events, changes, debounce)
+	return &Watcher{Changes: changes, source: source}, nil
+}
+
+// Stops watching, the Changes channel is closed once the pending changes are flushed
+func (watcher *Watcher) Close() error {
+	return watcher.source.close()
+}
+
+func debounceEvents(events <-chan string, changes chan<- []string, debounce time.Duration) {
+	defer close(changes)
+	pending := map[string]bool{}
+	var quiet, deadline <-chan time.Time
+	flush := func() {
+		if len(pending) > 0 {
+			paths := make([]string, 0, len(pending))
+			for path := range pending {
+				paths = append(paths, path)
+			}
+			sort.Strings(paths)
+			changes <- paths
+		}
+		pending = map[string]bool{}
+		quiet, deadline = nil, nil
+	}
+	for {
+		select {
+		case path, ok := <-events:
+			if !ok {
+				flush()
+				return
+			}
+			pending[path] = true
+			quiet = time.After(debounce)
+			if deadline == nil {
+				deadline = time.After(maxWaitFactor * debounce)
+			}
+		case <-quiet:
+			flush()
+		case <-deadline:
+			flush()
+		}
+	}
+}